@@ -0,0 +1,182 @@
+package validator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestCrossFieldRules(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		data    map[string]any
+		rules   map[string][]string
+		wantErr bool
+	}{
+		{
+			name:    "same matching values",
+			data:    map[string]any{"password": "nevergonna", "repeat": "nevergonna"},
+			rules:   map[string][]string{"repeat": {"same:password"}},
+			wantErr: false,
+		},
+		{
+			name:    "same different values",
+			data:    map[string]any{"password": "nevergonna", "repeat": "giveyouup"},
+			rules:   map[string][]string{"repeat": {"same:password"}},
+			wantErr: true,
+		},
+		{
+			name:    "same compares numbers by value",
+			data:    map[string]any{"total": 5, "expected": 5.0},
+			rules:   map[string][]string{"total": {"same:expected"}},
+			wantErr: false,
+		},
+		{
+			name:    "same missing param",
+			data:    map[string]any{"repeat": "nevergonna"},
+			rules:   map[string][]string{"repeat": {"same"}},
+			wantErr: true,
+		},
+		{
+			name:    "different with different values",
+			data:    map[string]any{"password": "letyoudown", "old_password": "nevergonna"},
+			rules:   map[string][]string{"password": {"different:old_password"}},
+			wantErr: false,
+		},
+		{
+			name:    "different with equal values",
+			data:    map[string]any{"password": "nevergonna", "old_password": "nevergonna"},
+			rules:   map[string][]string{"password": {"different:old_password"}},
+			wantErr: true,
+		},
+		{
+			name:    "confirmed matches",
+			data:    map[string]any{"password": "nevergonna", "password_confirmation": "nevergonna"},
+			rules:   map[string][]string{"password": {"confirmed"}},
+			wantErr: false,
+		},
+		{
+			name:    "confirmed missing confirmation",
+			data:    map[string]any{"password": "nevergonna"},
+			rules:   map[string][]string{"password": {"confirmed"}},
+			wantErr: true,
+		},
+		{
+			name:    "confirmed mismatch",
+			data:    map[string]any{"password": "nevergonna", "password_confirmation": "giveyouup"},
+			rules:   map[string][]string{"password": {"confirmed"}},
+			wantErr: true,
+		},
+		{
+			name:    "gt referencing field passes",
+			data:    map[string]any{"price": 20, "min_price": 10},
+			rules:   map[string][]string{"price": {"numeric", "gt:min_price"}},
+			wantErr: false,
+		},
+		{
+			name:    "gt referencing field fails",
+			data:    map[string]any{"price": 5, "min_price": 10},
+			rules:   map[string][]string{"price": {"numeric", "gt:min_price"}},
+			wantErr: true,
+		},
+		{
+			name:    "gt referencing missing field",
+			data:    map[string]any{"price": 5},
+			rules:   map[string][]string{"price": {"numeric", "gt:min_price"}},
+			wantErr: true,
+		},
+		{
+			name:    "gt referencing non-numeric field",
+			data:    map[string]any{"price": 5, "min_price": "ten"},
+			rules:   map[string][]string{"price": {"numeric", "gt:min_price"}},
+			wantErr: true,
+		},
+		{
+			name:    "lt referencing date field passes",
+			data:    map[string]any{"start_date": start, "end_date": end},
+			rules:   map[string][]string{"start_date": {"lt:end_date"}},
+			wantErr: false,
+		},
+		{
+			name:    "lt referencing date field fails",
+			data:    map[string]any{"start_date": end, "end_date": start},
+			rules:   map[string][]string{"start_date": {"lt:end_date"}},
+			wantErr: true,
+		},
+		{
+			name:    "lt referencing date string field passes",
+			data:    map[string]any{"start_date": "2026-01-01", "end_date": "2026-01-02T09:00:00Z"},
+			rules:   map[string][]string{"start_date": {"lt:end_date"}},
+			wantErr: false,
+		},
+		{
+			name:    "gt referencing date string field fails",
+			data:    map[string]any{"end_date": "2026-01-01", "start_date": "2026-01-02"},
+			rules:   map[string][]string{"end_date": {"gt:start_date"}},
+			wantErr: true,
+		},
+		{
+			name:    "gt compares time with date string",
+			data:    map[string]any{"end_date": end, "start_date": "2026-01-01T12:00:00+01:00"},
+			rules:   map[string][]string{"end_date": {"gt:start_date"}},
+			wantErr: false,
+		},
+		{
+			name:    "lt rejects date compared with non-date string",
+			data:    map[string]any{"start_date": start, "end_date": "tomorrow"},
+			rules:   map[string][]string{"start_date": {"lt:end_date"}},
+			wantErr: true,
+		},
+		{
+			name:    "lt referencing field with int and float",
+			data:    map[string]any{"score": 5, "limit": 5.5},
+			rules:   map[string][]string{"score": {"int", "lt:limit"}},
+			wantErr: false,
+		},
+		{
+			name:    "between referencing fields passes",
+			data:    map[string]any{"price": 15, "min_price": 10, "max_price": 20},
+			rules:   map[string][]string{"price": {"between:min_price,max_price"}},
+			wantErr: false,
+		},
+		{
+			name:    "between referencing fields fails",
+			data:    map[string]any{"price": 25, "min_price": 10, "max_price": 20},
+			rules:   map[string][]string{"price": {"between:min_price,max_price"}},
+			wantErr: true,
+		},
+		{
+			name:    "between mixing literal and field",
+			data:    map[string]any{"price": 15, "max_price": 20},
+			rules:   map[string][]string{"price": {"between:10,max_price"}},
+			wantErr: false,
+		},
+		{
+			name: "gt resolves wildcard field references",
+			data: map[string]any{
+				"items": []any{
+					map[string]any{"sale": 5, "cost": 3},
+					map[string]any{"sale": 2, "cost": 3},
+				},
+			},
+			rules:   map[string][]string{"items.*.sale": {"gt:items.*.cost"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			valid := v.Validate()
+
+			if valid == tt.wantErr {
+				t.Errorf("expected valid: %v, got: %v, errors: %v", !tt.wantErr, valid, v.Errors())
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestConditionalRequiredRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]any
+		rules   map[string][]string
+		wantErr bool
+	}{
+		{
+			name:    "required_if condition met and field missing",
+			data:    map[string]any{"account_type": "business"},
+			rules:   map[string][]string{"company_name": {"required_if:account_type,business", "string"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_if condition met and field present",
+			data:    map[string]any{"account_type": "business", "company_name": "Astley Ltd"},
+			rules:   map[string][]string{"company_name": {"required_if:account_type,business", "string"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_if condition not met",
+			data:    map[string]any{"account_type": "personal"},
+			rules:   map[string][]string{"company_name": {"required_if:account_type,business,enterprise", "string"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_if matches any listed value",
+			data:    map[string]any{"account_type": "enterprise", "company_name": ""},
+			rules:   map[string][]string{"company_name": {"required_if:account_type,business,enterprise"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_if compares non-string values",
+			data:    map[string]any{"is_company": true},
+			rules:   map[string][]string{"vat_number": {"required_if:is_company,true"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_if missing params",
+			data:    map[string]any{"company_name": "Astley Ltd"},
+			rules:   map[string][]string{"company_name": {"required_if:account_type"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_unless other matches",
+			data:    map[string]any{"account_type": "personal"},
+			rules:   map[string][]string{"company_name": {"required_unless:account_type,personal"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_unless other does not match",
+			data:    map[string]any{"account_type": "business"},
+			rules:   map[string][]string{"company_name": {"required_unless:account_type,personal"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_with other present",
+			data:    map[string]any{"street": "Never Gonna Lane"},
+			rules:   map[string][]string{"city": {"required_with:street,zip"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_with others empty",
+			data:    map[string]any{"street": ""},
+			rules:   map[string][]string{"city": {"required_with:street,zip"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_with_all only some present",
+			data:    map[string]any{"first_name": "Rick"},
+			rules:   map[string][]string{"full_name": {"required_with_all:first_name,last_name"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_with_all all present",
+			data:    map[string]any{"first_name": "Rick", "last_name": "Astley"},
+			rules:   map[string][]string{"full_name": {"required_with_all:first_name,last_name"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_without other missing",
+			data:    map[string]any{},
+			rules:   map[string][]string{"email": {"required_without:phone"}},
+			wantErr: true,
+		},
+		{
+			name:    "required_without other present",
+			data:    map[string]any{"phone": "9800000000"},
+			rules:   map[string][]string{"email": {"required_without:phone"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_without_all one present",
+			data:    map[string]any{"phone": "9800000000"},
+			rules:   map[string][]string{"username": {"required_without_all:phone,email"}},
+			wantErr: false,
+		},
+		{
+			name:    "required_without_all none present",
+			data:    map[string]any{},
+			rules:   map[string][]string{"username": {"required_without_all:phone,email"}},
+			wantErr: true,
+		},
+		{
+			name: "required_if resolves wildcards against the field",
+			data: map[string]any{
+				"items": []any{
+					map[string]any{"type": "digital"},
+					map[string]any{"type": "physical", "weight": 2},
+					map[string]any{"type": "physical"},
+				},
+			},
+			rules:   map[string][]string{"items.*.weight": {"required_if:items.*.type,physical", "numeric"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			valid := v.Validate()
+
+			if valid == tt.wantErr {
+				t.Errorf("expected valid: %v, got: %v, errors: %v", !tt.wantErr, valid, v.Errors())
+			}
+		})
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	tests := []struct {
+		field string
+		path  string
+		want  string
+	}{
+		{field: "company_name", path: "account_type", want: "account_type"},
+		{field: "items.3.price", path: "items.*.currency", want: "items.3.currency"},
+		{field: "orders.1.lines.2.qty", path: "orders.*.lines.*.unit", want: "orders.1.lines.2.unit"},
+	}
+
+	for _, tt := range tests {
+		if got := validator.ResolvePath(tt.field, tt.path); got != tt.want {
+			t.Errorf("ResolvePath(%q, %q) = %q, want %q", tt.field, tt.path, got, tt.want)
+		}
+	}
+}
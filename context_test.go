@@ -0,0 +1,116 @@
+package validator_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	"github.com/shivajichalise/validator/rules"
+)
+
+type tenantKey struct{}
+
+// tenantRule accepts values prefixed with the tenant found in the context.
+type tenantRule struct{}
+
+func (tenantRule) Name() string {
+	return "tenant_scoped"
+}
+
+func (tenantRule) Validate(field string, value any, params ...string) error {
+	return validator.Errorf("tenant_scoped", "%s requires a tenant", field)
+}
+
+func (tenantRule) ValidateContext(ctx context.Context, field string, value any, params ...string) error {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	if str, ok := value.(string); !ok || tenant == "" || !strings.HasPrefix(str, tenant) {
+		return validator.Errorf("tenant_scoped", "%s must belong to tenant %q", field, tenant)
+	}
+
+	return nil
+}
+
+// sameAsContextRule checks a field against another field read with DataFromContext.
+type sameAsContextRule struct{}
+
+func (sameAsContextRule) Name() string {
+	return "same_ctx"
+}
+
+func (sameAsContextRule) Validate(field string, value any, params ...string) error {
+	return validator.Errorf("same_ctx", "%s requires a context", field)
+}
+
+func (sameAsContextRule) ValidateContext(ctx context.Context, field string, value any, params ...string) error {
+	data, ok := validator.DataFromContext(ctx)
+	if !ok {
+		return validator.Errorf("same_ctx", "%s has no payload", field)
+	}
+
+	if other, _ := data.Get(params[0]); other != value {
+		return validator.Errorf("same_ctx", "%s must match %s", field, params[0])
+	}
+
+	return nil
+}
+
+func contextRegistry() *validator.Registry {
+	reg := validator.NewRegistry(rules.Builtins()...)
+	reg.Register(tenantRule{})
+	reg.Register(sameAsContextRule{})
+	reg.Replace(rules.EmailRule{Resolver: fakeMX})
+	return reg
+}
+
+func TestValidateContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme-")
+	data := map[string]any{"project": "acme-web", "password": "secret", "password_again": "secret"}
+	rules := map[string][]string{
+		"project":        {"required", "string", "tenant_scoped"},
+		"password_again": {"same_ctx:password"},
+	}
+
+	v := validator.Make(data, rules, validator.WithRegistry(contextRegistry()))
+	if !v.ValidateContext(ctx) {
+		t.Errorf("expected validation to pass, got: %v", v.Errors())
+	}
+
+	v = validator.Make(data, rules, validator.WithRegistry(contextRegistry()))
+	if v.Validate() {
+		t.Fatal("expected validation without a tenant to fail")
+	}
+
+	if errs := v.FieldErrors(); len(errs) != 1 || errs[0].Field != "project" {
+		t.Errorf("expected only the tenant rule to fail, got: %v", errs)
+	}
+}
+
+func TestSchemaValidateContext(t *testing.T) {
+	schema, err := validator.Compile(
+		map[string][]string{"email": {"email:rfc,dns"}},
+		validator.WithRegistry(contextRegistry()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := map[string]any{"email": "rick@astley.com"}
+	if errs := schema.ValidateContext(context.Background(), data); errs != nil {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := schema.ValidateContext(ctx, data)
+	if len(errs) != 1 || errs[0].Code != "email.dns_unavailable" {
+		t.Errorf("expected an email.dns_unavailable error, got: %v", errs)
+	}
+}
+
+func TestDataFromContextWithoutPayload(t *testing.T) {
+	if _, ok := validator.DataFromContext(context.Background()); ok {
+		t.Error("expected no payload in a plain context")
+	}
+}
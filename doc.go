@@ -4,11 +4,16 @@
 //
 // This package supports a wide range of rules such as:
 //
-//   - string, min, max
-//   - email (basic, rfc, dns)
-//   - numeric, int, float64
-//   - gt, lt (greater/less than)
+//   - string
+//   - min, max, size (string length, numeric value, element count or file size)
+//   - email (basic, rfc, strict, idn, spoof, nodisposable, dns), email_domain
+//   - numeric, int (accepting numeric strings unless :strict), float64, fits (e.g., fits:int16)
+//   - gt, lt (greater/less than a number or another field)
+//   - same, different, confirmed
 //   - boolean
+//   - required, nullable, sometimes, present, filled
+//   - required_if, required_unless, required_with, required_with_all,
+//     required_without, required_without_all
 //
 // Example usage:
 //
@@ -31,5 +36,27 @@
 //	    }
 //	}
 //
+// Structs can be validated directly using `validate` struct tags:
+//
+//	type Signup struct {
+//	    Email string `json:"email" validate:"email:rfc"`
+//	    Age   int    `json:"age" validate:"numeric|gt:18"`
+//	}
+//
+//	errs, err := validator.ValidateStruct(signup)
+//
+// Rules applied to many payloads can be compiled once into a Schema, which reports unknown
+// rules and malformed parameters up front and is safe for concurrent use:
+//
+//	schema, err := validator.Compile(rules)
+//	errs := schema.Validate(data)
+//
+// Numeric rules compare numbers exactly: integers of every signed and unsigned type are
+// compared as integers, and json.Number, *big.Int, *big.Float and *big.Rat are supported
+// (see CompareNumbers).
+//
+// ValidateContext passes a context.Context to rules implementing ContextRule, so I/O-bound
+// rules such as "email:dns" respect request deadlines and cancellation.
+//
 // See README for full examples, available rules, and custom rule extension.
 package validator
@@ -0,0 +1,372 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shivajichalise/validator"
+	"github.com/shivajichalise/validator/rules"
+)
+
+// fakeMX resolves a few domains in memory, so the dns mode can be tested offline.
+var fakeMX = rules.FakeResolver{MX: map[string][]*net.MX{
+	"astley.com":  {{Host: "mx.astley.com.", Pref: 10}},
+	"Example.ORG": {{Host: "mx.example.org.", Pref: 10}},
+	"nomx.com":    {},
+}}
+
+// slowResolver blocks every lookup until its context is done.
+type slowResolver struct{}
+
+func (slowResolver) LookupMX(ctx context.Context, _ string) ([]*net.MX, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (slowResolver) LookupIPAddr(ctx context.Context, _ string) ([]net.IPAddr, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func emailRegistry(rule rules.EmailRule) *validator.Registry {
+	reg := validator.NewRegistry(rules.Builtins()...)
+	reg.Replace(rule)
+	return reg
+}
+
+func TestEmailDNSWithFakeResolver(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		wantCode string
+	}{
+		{name: "domain with MX records", email: "rick@astley.com"},
+		{name: "domain matched case-insensitively", email: "rick@example.org"},
+		{name: "unknown domain", email: "rick@unknown.test", wantCode: "email.dns"},
+		{name: "domain without MX records", email: "rick@nomx.com", wantCode: "email.dns"},
+	}
+
+	reg := emailRegistry(rules.EmailRule{Resolver: fakeMX})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				map[string]any{"email": tt.email},
+				map[string][]string{"email": {"email:rfc,dns"}},
+				validator.WithRegistry(reg),
+			)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestEmailDNSTimeout(t *testing.T) {
+	reg := emailRegistry(rules.EmailRule{Resolver: slowResolver{}, Timeout: 10 * time.Millisecond})
+
+	v := validator.Make(
+		map[string]any{"email": "rick@astley.com"},
+		map[string][]string{"email": {"email:rfc,dns"}},
+		validator.WithRegistry(reg),
+	)
+	v.Validate()
+
+	errs := v.FieldErrors()
+	if len(errs) != 1 || errs[0].Code != "email.dns_unavailable" {
+		t.Fatalf("expected an email.dns_unavailable error, got: %v", errs)
+	}
+
+	if want := "email domain 'astley.com' could not be checked in time"; errs[0].Message != want {
+		t.Errorf("expected %q, got %q", want, errs[0].Message)
+	}
+}
+
+func TestEmailDNSContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rule := rules.EmailRule{Resolver: fakeMX}
+	err := rule.ValidateContext(ctx, "email", "rick@astley.com", "rfc", "dns")
+
+	var ruleErr *validator.RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Code != "email.dns_unavailable" {
+		t.Errorf("expected an email.dns_unavailable error, got: %v", err)
+	}
+
+	if err := rule.ValidateContext(context.Background(), "email", "rick@astley.com", "rfc", "dns"); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestEmailModes(t *testing.T) {
+	longLocal := strings.Repeat("r", 65)
+	longDomain := strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 58) + ".com"
+
+	tests := []struct {
+		name     string
+		email    string
+		rule     string
+		wantCode string
+	}{
+		{name: "dns alone with malformed input", email: "not an email", rule: "email:dns", wantCode: "email"},
+		{name: "dns alone with missing domain", email: "rick@", rule: "email:dns", wantCode: "email"},
+		{name: "dns alone with valid input", email: "rick@astley.com", rule: "email:dns"},
+		{name: "display name rejected by default", email: "Rick <rick@astley.com>", rule: "email", wantCode: "email.display_name"},
+		{name: "display name rejected in rfc mode", email: "Rick <rick@astley.com>", rule: "email:rfc", wantCode: "email.display_name"},
+		{name: "angle brackets rejected", email: "<rick@astley.com>", rule: "email:rfc", wantCode: "email.display_name"},
+		{name: "display name allowed", email: "Rick <rick@astley.com>", rule: "email:rfc,display_name"},
+		{name: "display name allowed with basic check", email: "Rick <rick@astley.com>", rule: "email:display_name"},
+		{name: "display name with dns", email: "Rick <rick@astley.com>", rule: "email:display_name,dns"},
+		{name: "display name with invalid address", email: "Rick <rick@astleycom>", rule: "email:display_name", wantCode: "email"},
+		{name: "local part of 64 bytes", email: strings.Repeat("r", 64) + "@astley.com", rule: "email:rfc"},
+		{name: "local part too long", email: longLocal + "@astley.com", rule: "email:rfc", wantCode: "email.length"},
+		{name: "local part too long in basic mode", email: longLocal + "@astley.com", rule: "email", wantCode: "email.length"},
+		{name: "address too long", email: "rick@" + longDomain, rule: "email:rfc", wantCode: "email.length"},
+		{name: "modes are case-insensitive", email: "rick@astley.com", rule: "email:RFC, DNS"},
+	}
+
+	reg := emailRegistry(rules.EmailRule{Resolver: fakeMX})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				map[string]any{"email": tt.email},
+				map[string][]string{"email": {tt.rule}},
+				validator.WithRegistry(reg),
+			)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestEmailUnknownMode(t *testing.T) {
+	_, err := validator.Compile(map[string][]string{"email": {"email:rfc,dsn"}})
+
+	var ruleErr *validator.RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Code != "email.param" {
+		t.Fatalf("expected an email.param error, got: %v", err)
+	}
+
+	if want := "validator: email: unknown email mode 'dsn' (use rfc, strict, idn, spoof, nodisposable, dns or display_name)"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestAdvancedEmailModes(t *testing.T) {
+	resolver := rules.FakeResolver{
+		MX: map[string][]*net.MX{
+			"astley.com":             {{Host: "mx.astley.com.", Pref: 10}},
+			"xn--bcher-kva.de":       {{Host: "mx.xn--bcher-kva.de.", Pref: 10}},
+			"xn--fsqu00a.xn--4rr70v": {{Host: "mx.example.", Pref: 10}},
+			"nomail.example":         {{Host: ".", Pref: 0}},
+		},
+		IP: map[string][]net.IPAddr{
+			"rick.dev":       {{IP: net.ParseIP("192.0.2.1")}},
+			"nomail.example": {{IP: net.ParseIP("192.0.2.2")}},
+		},
+	}
+	reg := emailRegistry(rules.EmailRule{Resolver: resolver})
+
+	tests := []struct {
+		name     string
+		email    string
+		rule     string
+		wantCode string
+	}{
+		{name: "non-ascii rejected without idn", email: "rick@bücher.de", rule: "email", wantCode: "email.idn"},
+		{name: "non-ascii local part rejected without idn", email: "rück@astley.com", rule: "email:strict", wantCode: "email.idn"},
+		{name: "idn domain", email: "rick@bücher.de", rule: "email:idn"},
+		{name: "idn local part and domain", email: "用户@例子.广告", rule: "email:idn"},
+		{name: "idn with symbols is still invalid", email: "rick☃@bücher.de", rule: "email:idn", wantCode: "email"},
+		{name: "idn domain looked up as punycode", email: "rick@Bücher.de", rule: "email:idn,dns"},
+		{name: "idn chinese domain looked up as punycode", email: "用户@例子.广告", rule: "email:rfc,idn,dns"},
+		{name: "idn with strict", email: "rick@bücher.de", rule: "email:strict,idn"},
+		{name: "strict accepts plain address", email: "rick.astley+music@astley.com", rule: "email:strict"},
+		{name: "strict rejects quoted local part", email: `"rick astley"@astley.com`, rule: "email:strict", wantCode: "email.strict"},
+		{name: "rfc accepts quoted local part", email: `"rick astley"@astley.com`, rule: "email:rfc"},
+		{name: "strict rejects address literal", email: "rick@[192.0.2.1]", rule: "email:strict", wantCode: "email.strict"},
+		{name: "strict rejects single-label domain", email: "rick@localhost", rule: "email:strict", wantCode: "email.strict"},
+		{name: "strict rejects hyphenated label edges", email: "rick@-astley-.com", rule: "email:strict", wantCode: "email.strict"},
+		{name: "strict rejects underscore", email: "rick@astley_music.com", rule: "email:strict", wantCode: "email.strict"},
+		{name: "strict rejects numeric tld", email: "rick@astley.123", rule: "email:strict", wantCode: "email.strict"},
+		{name: "spoof rejects latin mixed with cyrillic", email: "pаypal@astley.com", rule: "email:idn,spoof", wantCode: "email.spoof"},
+		{name: "spoof rejects mixed-script domain label", email: "rick@аpple.com", rule: "email:idn,spoof", wantCode: "email.spoof"},
+		{name: "spoof accepts single script", email: "рик@astley.com", rule: "email:idn,spoof"},
+		{name: "spoof accepts japanese", email: "山田たろう@astley.com", rule: "email:idn,spoof"},
+		{name: "spoof accepts latin with digits", email: "rick1966@astley.com", rule: "email:spoof"},
+		{name: "dns falls back to A records", email: "rick@rick.dev", rule: "email:dns"},
+		{name: "dns rejects null MX", email: "rick@nomail.example", rule: "email:dns", wantCode: "email.null_mx"},
+		{name: "dns rejects domain without records", email: "rick@gone.example", rule: "email:dns", wantCode: "email.dns"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				map[string]any{"email": tt.email},
+				map[string][]string{"email": {tt.rule}},
+				validator.WithRegistry(reg),
+			)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestEmailNoDisposable(t *testing.T) {
+	reg := emailRegistry(rules.EmailRule{
+		Resolver: fakeMX,
+		Allow:    rules.NewDomainList("partner.mailinator.com"),
+		Block:    rules.NewDomainList("spam.example"),
+	})
+
+	tests := []struct {
+		name     string
+		email    string
+		rule     string
+		wantCode string
+	}{
+		{name: "regular domain", email: "rick@astley.com", rule: "email:rfc,nodisposable"},
+		{name: "built-in disposable domain", email: "rick@mailinator.com", rule: "email:rfc,nodisposable", wantCode: "email.disposable"},
+		{name: "disposable domain matched case-insensitively", email: "rick@YOPMAIL.com", rule: "email:nodisposable", wantCode: "email.disposable"},
+		{name: "subdomain of disposable domain", email: "rick@eu.mailinator.com", rule: "email:nodisposable", wantCode: "email.disposable"},
+		{name: "similar domain is not a subdomain", email: "rick@notamailinator.com", rule: "email:nodisposable"},
+		{name: "allowlisted subdomain", email: "rick@partner.mailinator.com", rule: "email:nodisposable"},
+		{name: "blocklisted domain", email: "rick@mail.spam.example", rule: "email:nodisposable", wantCode: "email.disposable"},
+		{name: "disposable domain accepted without the mode", email: "rick@mailinator.com", rule: "email:rfc"},
+		{name: "checked before dns", email: "rick@mailinator.com", rule: "email:rfc,nodisposable,dns", wantCode: "email.disposable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				map[string]any{"email": tt.email},
+				map[string][]string{"email": {tt.rule}},
+				validator.WithRegistry(reg),
+			)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestEmailDomainRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    any
+		rule     string
+		wantCode string
+	}{
+		{name: "listed domain", email: "rick@astley.com", rule: "email_domain:in,astley.com,example.org"},
+		{name: "subdomain of listed domain", email: "rick@Music.Astley.com", rule: "email_domain:in,astley.com"},
+		{name: "unlisted domain", email: "rick@gmail.com", rule: "email_domain:in,astley.com", wantCode: "email_domain"},
+		{name: "blocked domain", email: "rick@gmail.com", rule: "email_domain:not_in,gmail.com,yahoo.com", wantCode: "email_domain"},
+		{name: "subdomain of blocked domain", email: "rick@uk.yahoo.com", rule: "email_domain:not_in,gmail.com,yahoo.com", wantCode: "email_domain"},
+		{name: "unblocked domain", email: "rick@astley.com", rule: "email_domain:not_in,gmail.com"},
+		{name: "international domain matches punycode", email: "rick@bücher.de", rule: "email_domain:in,xn--bcher-kva.de"},
+		{name: "display name", email: "Rick <rick@astley.com>", rule: "email_domain:in,astley.com"},
+		{name: "missing domain", email: "rick@", rule: "email_domain:in,astley.com", wantCode: "email_domain.type"},
+		{name: "not a string", email: 42, rule: "email_domain:in,astley.com", wantCode: "email_domain.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				map[string]any{"email": tt.email},
+				map[string][]string{"email": {tt.rule}},
+			)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestEmailDomainRuleParams(t *testing.T) {
+	for _, rule := range []string{"email_domain", "email_domain:in", "email_domain:astley.com", "email_domain:in,rick@astley.com"} {
+		_, err := validator.Compile(map[string][]string{"email": {rule}})
+
+		var ruleErr *validator.RuleError
+		if !errors.As(err, &ruleErr) || ruleErr.Code != "email_domain.param" {
+			t.Errorf("%s: expected an email_domain.param error, got: %v", rule, err)
+		}
+	}
+}
+
+func TestEmailDomainReusesParsedDomains(t *testing.T) {
+	params := []string{"in"}
+	for i := range 100 {
+		params = append(params, fmt.Sprintf("bücher%d.de", i))
+	}
+
+	rule := rules.EmailDomainRule{}
+	if err := rule.Validate("email", "rick@bücher99.de", params...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Building the list of 100 domains allocates for each of them; a reused list does not.
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = rule.Validate("email", "rick@bücher99.de", params...)
+	})
+	if allocs > 50 {
+		t.Errorf("expected the parsed domains to be reused, got %v allocations per call", allocs)
+	}
+}
+
+func TestDomainListLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "domains.txt")
+	if err := os.WriteFile(path, []byte("# extra domains\n\nThrowaway.example\n*.burner.test\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	list := rules.NewDomainList()
+	if err := list.LoadFile(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for domain, want := range map[string]bool{
+		"throwaway.example":     true,
+		"mx.throwaway.example":  true,
+		"burner.test":           true,
+		"a.burner.test.":        true,
+		"example":               false,
+		"throwaway.example.com": false,
+	} {
+		if got := list.Contains(domain); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", domain, got, want)
+		}
+	}
+
+	if err := list.Load(strings.NewReader("ok.example\nnot a domain\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error on line 2, got: %v", err)
+	}
+
+	if list.Contains("ok.example") {
+		t.Error("expected a failed load to add no domains")
+	}
+
+	if err := list.LoadFile(filepath.Join(t.TempDir(), "missing.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected a not-exist error, got: %v", err)
+	}
+}
+
+func TestDisposableDomainsBuiltin(t *testing.T) {
+	if rules.DisposableDomains.Len() == 0 {
+		t.Fatal("expected the built-in disposable domain list to be loaded")
+	}
+
+	if !rules.DisposableDomains.Contains("mailinator.com") {
+		t.Error("expected mailinator.com to be a disposable domain")
+	}
+}
@@ -0,0 +1,244 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Error codes reported by the validator itself rather than by a rule.
+const (
+	// CodeRequired is reported when a "required" field is missing or empty.
+	CodeRequired = "required"
+
+	// CodePresent is reported when a "present" field is missing.
+	CodePresent = "present"
+
+	// CodeFilled is reported when a "filled" field is present but empty.
+	CodeFilled = "filled"
+
+	// CodeUnknownRule is reported when a rule expression names an unregistered rule.
+	CodeUnknownRule = "unknown_rule"
+
+	// CodeInvalidRule is reported when a rule expression is malformed (see ParseRules).
+	CodeInvalidRule = "invalid_rule"
+)
+
+// Args holds the named values substituted into message placeholders,
+// e.g. Args{"min": 5} fills ":min".
+type Args map[string]any
+
+// RuleError is an error returned by a rule that carries a stable error code.
+// Rules should return one from Validate (see NewError and Errorf) so callers can
+// tell failures apart without parsing messages.
+type RuleError struct {
+	// Code identifies the failure, e.g. "min" or "email.dns".
+	// By convention it is the rule name, optionally followed by a dot and a reason.
+	Code string
+
+	// Message is the human-readable description of the failure.
+	Message string
+
+	// Template is the message with its placeholders, e.g. ":attribute must be at least :min characters".
+	// It is empty for errors created with Errorf.
+	Template string
+
+	// Args holds the values for the template's placeholders, other than ":attribute".
+	Args Args
+}
+
+// Error returns the human-readable message.
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+// NewError returns a RuleError for field with the given code and a message rendered
+// from template. ":attribute" is replaced by field and every other placeholder, such
+// as ":min", by the matching entry in args. The template and args are kept so the
+// validator can render the message again with custom messages and attribute names.
+func NewError(field, code, template string, args Args) error {
+	return &RuleError{
+		Code:     code,
+		Message:  renderMessage(template, field, args, nil),
+		Template: template,
+		Args:     args,
+	}
+}
+
+// Errorf returns a RuleError with the given code and a message formatted
+// according to format, like fmt.Errorf.
+func Errorf(code, format string, args ...any) error {
+	return &RuleError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// FieldError describes a single failed validation of one field.
+// It is usable with errors.As on the error returned by Validator.Err.
+type FieldError struct {
+	// Field is the concrete path of the field, e.g. "items.3.price".
+	Field string
+
+	// Rule is the name of the rule that failed, e.g. "min".
+	Rule string
+
+	// Params holds the parameters passed to the rule, e.g. ["5"] for "min:5".
+	Params []string
+
+	// Value is the offending value, or nil if the field was missing.
+	Value any
+
+	// Code is a stable identifier for the failure. It is the RuleError code
+	// returned by the rule, or the rule name if the rule returned a plain error.
+	Code string
+
+	// Args holds the values of the message placeholders, e.g. {"min": 5}.
+	Args Args
+
+	// Message is the human-readable description of the failure, rendered
+	// with any custom messages and attribute names given to the Validator.
+	Message string
+
+	// Err is the underlying error returned by the rule or the validator.
+	Err error
+}
+
+// Error returns the human-readable message.
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error returned by the rule.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors lists every failure of a validation run, in the order they were found.
+type ValidationErrors []*FieldError
+
+// Error joins all failure messages, each prefixed with its field.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Field + ": " + fe.Message
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual field errors, so errors.As can match a *FieldError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+
+	return errs
+}
+
+// Errors returns the plain string view of the failures, keyed by field.
+func (e ValidationErrors) Errors() Errors {
+	errs := make(Errors)
+	for _, fe := range e {
+		errs[fe.Field] = append(errs[fe.Field], fe.Message)
+	}
+
+	return errs
+}
+
+// Ordered returns the plain string view of the failures, with fields in the
+// order their first failure was found.
+func (e ValidationErrors) Ordered() OrderedErrors {
+	var errs OrderedErrors
+	index := make(map[string]int)
+
+	for _, fe := range e {
+		i, ok := index[fe.Field]
+		if !ok {
+			i = len(errs)
+			index[fe.Field] = i
+			errs = append(errs, FieldMessages{Field: fe.Field})
+		}
+		errs[i].Messages = append(errs[i].Messages, fe.Message)
+	}
+
+	return errs
+}
+
+// Field returns the failures of a single field, in the order they were found.
+func (e ValidationErrors) Field(field string) ValidationErrors {
+	var errs ValidationErrors
+	for _, fe := range e {
+		if fe.Field == field {
+			errs = append(errs, fe)
+		}
+	}
+
+	return errs
+}
+
+// Fields returns the fields with errors, sorted by path, for iterating
+// over the errors in a stable order.
+func (e Errors) Fields() []string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// FieldMessages holds the error messages of a single field.
+type FieldMessages struct {
+	Field    string
+	Messages []string
+}
+
+// OrderedErrors is the plain string view of the failures as a list of fields,
+// in the order they were validated.
+type OrderedErrors []FieldMessages
+
+// Get returns the messages of a field, or nil if it has none.
+func (e OrderedErrors) Get(field string) []string {
+	for _, fm := range e {
+		if fm.Field == field {
+			return fm.Messages
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the errors as a JSON object of messages keyed by field,
+// with the keys in the same order as the list (e.g. {"name":["..."],"age":["..."]}).
+func (e OrderedErrors) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, fm := range e {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(fm.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		messages, err := json.Marshal(fm.Messages)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(messages)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
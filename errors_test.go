@@ -0,0 +1,127 @@
+package validator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestFieldErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       map[string]any
+		rules      map[string][]string
+		wantRule   string
+		wantCode   string
+		wantParams []string
+	}{
+		{
+			name:       "rule failure",
+			data:       map[string]any{"username": "rick"},
+			rules:      map[string][]string{"username": {"min:5"}},
+			wantRule:   "min",
+			wantCode:   "min",
+			wantParams: []string{"5"},
+		},
+		{
+			name:     "rule failure with reason",
+			data:     map[string]any{"username": "  "},
+			rules:    map[string][]string{"username": {"string"}},
+			wantRule: "string",
+			wantCode: "string.empty",
+		},
+		{
+			name:       "invalid parameter",
+			data:       map[string]any{"username": "rickastley"},
+			rules:      map[string][]string{"username": {"min:five"}},
+			wantRule:   "min",
+			wantCode:   "min.param",
+			wantParams: []string{"five"},
+		},
+		{
+			name:     "presence failure",
+			data:     map[string]any{},
+			rules:    map[string][]string{"username": {"required", "string"}},
+			wantRule: "required",
+			wantCode: validator.CodeRequired,
+		},
+		{
+			name:     "unknown rule",
+			data:     map[string]any{"username": "rickastley"},
+			rules:    map[string][]string{"username": {"slug"}},
+			wantRule: "slug",
+			wantCode: validator.CodeUnknownRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			if v.Validate() {
+				t.Fatal("expected validation to fail")
+			}
+
+			fieldErrors := v.FieldErrors()
+			if len(fieldErrors) != 1 {
+				t.Fatalf("expected 1 field error, got: %v", fieldErrors)
+			}
+
+			fe := fieldErrors[0]
+			if fe.Field != "username" || fe.Rule != tt.wantRule || fe.Code != tt.wantCode {
+				t.Errorf("got field %q rule %q code %q, want username/%s/%s", fe.Field, fe.Rule, fe.Code, tt.wantRule, tt.wantCode)
+			}
+
+			if len(fe.Params) != len(tt.wantParams) || (len(fe.Params) > 0 && !reflect.DeepEqual(fe.Params, tt.wantParams)) {
+				t.Errorf("got params %v, want %v", fe.Params, tt.wantParams)
+			}
+
+			if v.Errors()["username"][0] != fe.Message {
+				t.Errorf("string view %v does not match message %q", v.Errors(), fe.Message)
+			}
+		})
+	}
+}
+
+func TestErrAs(t *testing.T) {
+	v := validator.Make(
+		map[string]any{"age": "old"},
+		map[string][]string{"age": {"int"}},
+	)
+
+	if v.Validate() {
+		t.Fatal("expected validation to fail")
+	}
+
+	err := v.Err()
+
+	var fe *validator.FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("expected errors.As to find a *FieldError in %v", err)
+	}
+
+	if fe.Field != "age" || fe.Value != "old" || fe.Code != "int" {
+		t.Errorf("unexpected field error: %+v", fe)
+	}
+
+	var ruleErr *validator.RuleError
+	if !errors.As(fe, &ruleErr) || ruleErr.Code != "int" {
+		t.Errorf("expected wrapped *RuleError with code int, got: %v", fe.Err)
+	}
+
+	var all validator.ValidationErrors
+	if !errors.As(err, &all) || len(all) != 1 {
+		t.Errorf("expected ValidationErrors with one entry, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(all.Errors(), v.Errors()) {
+		t.Errorf("expected string view %v, got %v", v.Errors(), all.Errors())
+	}
+
+	valid := validator.Make(map[string]any{"age": 1}, map[string][]string{"age": {"int"}})
+	if !valid.Validate() || valid.Err() != nil {
+		t.Errorf("expected nil Err for passing validation, got: %v", valid.Err())
+	}
+}
@@ -16,6 +16,7 @@ func main() {
 		"bio":            "nevergonnagiveyouup",
 		"tagline":        "nevergonnaletyoudown",
 		"age":            "17",
+		"zip_code":       "44600",
 		"height":         17.5,
 		"login_attempts": 17,
 		"max_score":      80,
@@ -30,7 +31,8 @@ func main() {
 		"backup_email":   {"email:rfc,dns"},             // should fail (invalid MX)
 		"bio":            {"string", "min:19"},          // should pass
 		"tagline":        {"string", "min:5", "max:19"}, // should pass
-		"age":            {"numeric"},                   // should pass
+		"age":            {"numeric"},                   // should pass: numeric strings allowed
+		"zip_code":       {"numeric:strict"},            // should fail: strict mode rejects strings
 		"height":         {"int"},                       // should fail: float given
 		"login_attempts": {"float64"},                   // should fail: int given
 		"max_score":      {"int", "lt:81"},              // should pass
@@ -0,0 +1,68 @@
+package validator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestLengthModes(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		rules    []string
+		wantCode string
+	}{
+		{name: "nepali counts characters not bytes", value: "नेपाल", rules: []string{"min:5", "max:5"}},
+		{name: "nepali exceeds byte limit", value: "नेपाल", rules: []string{"max:10,bytes"}, wantCode: "max.bytes"},
+		{name: "nepali within byte limit", value: "नेपाल", rules: []string{"min:15,bytes", "max:15,bytes"}},
+		{name: "explicit runes mode", value: "नेपाल", rules: []string{"max:5,runes"}},
+		{name: "vowel signs join their consonant", value: "नेपाल", rules: []string{"min:3,graphemes", "max:3,graphemes"}},
+		{name: "emoji counts as one rune", value: "🎸", rules: []string{"min:1", "max:1"}},
+		{name: "zwj sequence is one grapheme", value: "👩‍💻", rules: []string{"max:1,graphemes"}},
+		{name: "zwj sequence is several runes", value: "👩‍💻", rules: []string{"max:1"}, wantCode: "max"},
+		{name: "skin tone modifier is one grapheme", value: "👍🏽", rules: []string{"max:1,graphemes"}},
+		{name: "flag is one grapheme", value: "🇳🇵", rules: []string{"max:1,graphemes"}},
+		{name: "two flags are two graphemes", value: "🇳🇵🇯🇵", rules: []string{"min:2,graphemes", "max:2,graphemes"}},
+		{name: "combining accent is one grapheme", value: "e\u0301", rules: []string{"max:1,graphemes"}},
+		{name: "hangul jamo form one grapheme", value: "\u1100\u1161\u11a8", rules: []string{"max:1,graphemes"}},
+		{name: "crlf is one grapheme", value: "a\r\nb", rules: []string{"max:3,graphemes"}},
+		{name: "too short in graphemes", value: "👩‍💻👩‍💻", rules: []string{"min:3,graphemes"}, wantCode: "min"},
+		{name: "too short in bytes", value: "ab", rules: []string{"min:3,bytes"}, wantCode: "min.bytes"},
+		{name: "mode is case-insensitive", value: "ab", rules: []string{"max:2,Bytes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(map[string]any{"name": tt.value}, map[string][]string{"name": tt.rules})
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestLengthModeMessages(t *testing.T) {
+	v := validator.Make(
+		map[string]any{"bio": "नेपाल"},
+		map[string][]string{"bio": {"max:10,bytes"}},
+	)
+	v.Validate()
+
+	if got := v.Errors()["bio"]; len(got) != 1 || got[0] != "bio must be at most 10 bytes" {
+		t.Errorf("unexpected messages: %v", got)
+	}
+}
+
+func TestLengthModeParams(t *testing.T) {
+	for _, expr := range []string{"max:20,glyphs", "min:5,bytes,runes", "max:twenty,bytes"} {
+		_, err := validator.Compile(map[string][]string{"name": {expr}})
+
+		var ruleErr *validator.RuleError
+		if !errors.As(err, &ruleErr) || (ruleErr.Code != "max.param" && ruleErr.Code != "min.param") {
+			t.Errorf("%s: expected a param error, got: %v", expr, err)
+		}
+	}
+}
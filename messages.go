@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Placeholder names with special meaning in message templates.
+const (
+	// placeholderAttribute is replaced by the display name of the field being validated.
+	placeholderAttribute = "attribute"
+
+	// placeholderOther is replaced by the display name of another field referenced by the rule.
+	placeholderOther = "other"
+
+	// placeholderOthers is replaced by the display names of a list of referenced fields.
+	placeholderOthers = "others"
+)
+
+// placeholderRegex matches placeholders such as ":attribute" or ":min" in message templates.
+var placeholderRegex = regexp.MustCompile(`:([A-Za-z_]+)`)
+
+// WithMessages sets custom error messages, keyed by rule name or error code
+// (e.g. "min" or "email.dns") for every field, or by "field.rule" / "field.code"
+// (e.g. "username.min") for a single field. Field keys may use the same wildcard
+// patterns as the rules (e.g. "items.*.price.gt").
+//
+// Messages may contain placeholders: ":attribute" for the field's display name,
+// ":other" for a referenced field, and the rule's own arguments such as ":min",
+// ":max" or ":value". Capitalized placeholders (":Attribute") capitalize the value.
+func WithMessages(messages map[string]string) Option {
+	return func(v *Validator) {
+		v.messages = messages
+	}
+}
+
+// WithAttributes sets human-friendly display names for fields, keyed by field path
+// or rules pattern (e.g. "date_of_birth" or "items.*.price"). Display names replace
+// the field name in every message, so "date_of_birth" can render as "date of birth".
+func WithAttributes(attributes map[string]string) Option {
+	return func(v *Validator) {
+		v.attributes = attributes
+	}
+}
+
+// fail records a failed validation of field, matched by the rules pattern, using the
+// error returned by a rule. The message is rendered from a custom message if one
+// matches, otherwise from the locale's catalog message for the error code, then
+// from the rule's template, falling back to the error text. The catalog is only
+// consulted for a *RuleError with a code or template: a plain error from a custom
+// rule keeps its own text even if the rule shares a built-in rule's name.
+func (v *Validator) fail(pattern, field, rule string, params []string, value any, err error) {
+	code := rule
+	var args Args
+	var template string
+
+	var ruleErr *RuleError
+	if errors.As(err, &ruleErr) {
+		if ruleErr.Code != "" {
+			code = ruleErr.Code
+		}
+		args = ruleErr.Args
+		template = ruleErr.Template
+	}
+
+	if custom, ok := v.customMessage(pattern, field, rule, code); ok {
+		template = custom
+	} else if ruleErr != nil && (ruleErr.Code != "" || ruleErr.Template != "") {
+		if translated, ok := v.translate(code, args); ok {
+			template = translated
+		}
+	}
+
+	message := err.Error()
+	if template != "" {
+		message = renderMessage(template, v.displayName(field, pattern), args, v.otherName)
+	}
+
+	v.addError(&FieldError{
+		Field:   field,
+		Rule:    rule,
+		Params:  params,
+		Value:   value,
+		Code:    code,
+		Args:    args,
+		Message: message,
+		Err:     err,
+	})
+}
+
+// customMessage finds the custom message for a failure, preferring field-specific
+// keys over global ones and error codes over rule names.
+func (v *Validator) customMessage(pattern, field, rule, code string) (string, bool) {
+	if len(v.messages) == 0 {
+		return "", false
+	}
+
+	keys := []string{
+		field + pathSeparator + code,
+		pattern + pathSeparator + code,
+		field + pathSeparator + rule,
+		pattern + pathSeparator + rule,
+		code,
+		rule,
+	}
+
+	for _, key := range keys {
+		message, ok := v.messages[key]
+		if ok {
+			return message, true
+		}
+	}
+
+	return "", false
+}
+
+// translate returns the catalog message for an error code in the Validator's locale.
+func (v *Validator) translate(code string, args Args) (string, bool) {
+	translator := v.translator
+	if translator == nil {
+		translator = defaultTranslator
+	}
+
+	return translator.Translate(v.locale, code, args)
+}
+
+// displayName returns the display name of a field, looked up by concrete path first
+// and then by rules pattern, so wildcard display names (e.g. "items.*.price") apply
+// to every expanded element. Falls back to the field path itself.
+func (v *Validator) displayName(field, pattern string) string {
+	if display, ok := v.attributes[field]; ok {
+		return display
+	}
+
+	if display, ok := v.attributes[pattern]; ok {
+		return display
+	}
+
+	return field
+}
+
+// otherName returns the display name of a field referenced by a rule parameter.
+func (v *Validator) otherName(field string) string {
+	return v.displayName(field, field)
+}
+
+// renderMessage replaces the placeholders in template. ":attribute" becomes attribute,
+// and any other placeholder the matching entry of args. ":other" and ":others" name
+// fields, so they are passed through displayName when it is not nil. Placeholders
+// without a value are left as they are.
+func renderMessage(template, attribute string, args Args, displayName func(string) string) string {
+	return placeholderRegex.ReplaceAllStringFunc(template, func(match string) string {
+		name := match[1:]
+		key := strings.ToLower(name)
+
+		var value string
+		switch key {
+		case placeholderAttribute:
+			value = attribute
+		default:
+			arg, ok := args[key]
+			if !ok {
+				return match
+			}
+			value = formatArg(key, arg, displayName)
+		}
+
+		return applyCase(name, value)
+	})
+}
+
+// formatArg formats a placeholder value. Field references under ":other" and
+// ":others" are replaced by their display names, and lists are joined.
+func formatArg(key string, arg any, displayName func(string) string) string {
+	isField := displayName != nil && (key == placeholderOther || key == placeholderOthers)
+
+	switch a := arg.(type) {
+	case string:
+		if isField {
+			return displayName(a)
+		}
+		return a
+	case []string:
+		parts := make([]string, len(a))
+		for i, part := range a {
+			parts[i] = part
+			if isField {
+				parts[i] = displayName(part)
+			}
+		}
+		return strings.Join(parts, " / ")
+	default:
+		return fmt.Sprint(arg)
+	}
+}
+
+// applyCase capitalizes value when the placeholder name is capitalized (":Attribute")
+// and upper-cases it when the name is all upper case (":ATTRIBUTE").
+func applyCase(name, value string) string {
+	switch {
+	case name == strings.ToUpper(name):
+		return strings.ToUpper(value)
+	case unicode.IsUpper([]rune(name)[0]):
+		r, size := utf8.DecodeRuneInString(value)
+		return string(unicode.ToUpper(r)) + value[size:]
+	default:
+		return value
+	}
+}
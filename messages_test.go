@@ -0,0 +1,118 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestCustomMessages(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       map[string]any
+		rules      map[string][]string
+		messages   map[string]string
+		attributes map[string]string
+		field      string
+		want       string
+	}{
+		{
+			name:  "default message",
+			data:  map[string]any{"username": "rick"},
+			rules: map[string][]string{"username": {"min:5"}},
+			field: "username",
+			want:  "username must be at least 5 characters",
+		},
+		{
+			name:     "rule message with placeholders",
+			data:     map[string]any{"username": "rick"},
+			rules:    map[string][]string{"username": {"min:5"}},
+			messages: map[string]string{"min": "The :attribute needs :min or more characters."},
+			field:    "username",
+			want:     "The username needs 5 or more characters.",
+		},
+		{
+			name:  "field rule message wins over rule message",
+			data:  map[string]any{"username": "rick"},
+			rules: map[string][]string{"username": {"min:5"}},
+			messages: map[string]string{
+				"min":          "too short",
+				"username.min": "Pick a longer username.",
+			},
+			field: "username",
+			want:  "Pick a longer username.",
+		},
+		{
+			name:  "error code message wins over rule name",
+			data:  map[string]any{"username": "  "},
+			rules: map[string][]string{"username": {"string"}},
+			messages: map[string]string{
+				"string":       "must be text",
+				"string.empty": ":Attribute cannot be blank.",
+			},
+			field: "username",
+			want:  "Username cannot be blank.",
+		},
+		{
+			name:       "attribute display name in default message",
+			data:       map[string]any{},
+			rules:      map[string][]string{"date_of_birth": {"required"}},
+			attributes: map[string]string{"date_of_birth": "date of birth"},
+			field:      "date_of_birth",
+			want:       "date of birth is required",
+		},
+		{
+			name:       "other field display name",
+			data:       map[string]any{"password": "nevergonna", "password_confirmation": "giveyouup"},
+			rules:      map[string][]string{"password": {"confirmed"}},
+			attributes: map[string]string{"password_confirmation": "password confirmation"},
+			field:      "password",
+			want:       "password must be confirmed by a matching password confirmation",
+		},
+		{
+			name:       "list of other fields display names",
+			data:       map[string]any{},
+			rules:      map[string][]string{"email": {"required_without:phone_number,home_phone"}},
+			attributes: map[string]string{"phone_number": "phone number", "home_phone": "home phone"},
+			field:      "email",
+			want:       "email is required when phone number / home phone is not present",
+		},
+		{
+			name: "wildcard message and attribute",
+			data: map[string]any{
+				"items": []any{map[string]any{"price": 0}},
+			},
+			rules:      map[string][]string{"items.*.price": {"gt:0"}},
+			messages:   map[string]string{"items.*.price.gt": ":Attribute must be more than :value."},
+			attributes: map[string]string{"items.*.price": "item price"},
+			field:      "items.0.price",
+			want:       "Item price must be more than 0.",
+		},
+		{
+			name:     "unknown placeholder is kept",
+			data:     map[string]any{"age": "old"},
+			rules:    map[string][]string{"age": {"int"}},
+			messages: map[string]string{"int": ":attribute :nonsense"},
+			field:    "age",
+			want:     "age :nonsense",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(
+				tt.data,
+				tt.rules,
+				validator.WithMessages(tt.messages),
+				validator.WithAttributes(tt.attributes),
+			)
+			v.Validate()
+
+			messages := v.Errors()[tt.field]
+			if len(messages) != 1 || messages[0] != tt.want {
+				t.Errorf("expected message %q, got: %v", tt.want, v.Errors())
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package validator
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// decimalPattern matches decimal numbers such as "42", "-0.5", "1e9" or "6.02E+23".
+// Exponents are limited to four digits so parsing stays cheap on untrusted input.
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d{1,4})?$`)
+
+// NumberKind classifies values for the numeric rules, so that every rule treats
+// int8 and int64, or json.Number and *big.Int, the same way.
+type NumberKind int
+
+// Kinds of numeric values, as returned by NumberKindOf.
+const (
+	// NonNumeric is any value that is not a number.
+	NonNumeric NumberKind = iota
+
+	// IntegerKind is a signed or unsigned integer of any size, a *big.Int, or a
+	// json.Number written without a fraction or exponent (e.g., "42").
+	IntegerKind
+
+	// FloatKind is a float32, float64 or *big.Float.
+	FloatKind
+
+	// DecimalKind is an exact decimal: a *big.Rat or a json.Number written with a
+	// fraction or exponent (e.g., "9.99" or "1e3").
+	DecimalKind
+)
+
+// integerPattern matches integers such as "42" or "-7".
+var integerPattern = regexp.MustCompile(`^[+-]?\d+$`)
+
+// NumberKindOf classifies value, after passing it through Deref.
+// A json.Number that is not a valid decimal number is NonNumeric.
+func NumberKindOf(value any) NumberKind {
+	value = Deref(value)
+
+	switch n := value.(type) {
+	case json.Number:
+		switch {
+		case integerPattern.MatchString(string(n)):
+			return IntegerKind
+		case decimalPattern.MatchString(string(n)):
+			return DecimalKind
+		default:
+			return NonNumeric
+		}
+	case big.Int:
+		return IntegerKind
+	case big.Float:
+		return FloatKind
+	case big.Rat:
+		return DecimalKind
+	}
+
+	v := reflect.ValueOf(value)
+	switch {
+	case isSigned(v), isUnsigned(v):
+		return IntegerKind
+	case isFloat(v):
+		return FloatKind
+	default:
+		return NonNumeric
+	}
+}
+
+// Fits reports whether value can be stored in a Go variable of the given numeric
+// kind without overflow or loss: the integer kinds require a whole number within
+// their range, and float32 and float64 require a value within their range, which
+// is then rounded. NaN and infinite floats only fit float32 and float64.
+// This checks values decoded from JSON, which arrive as float64 or json.Number,
+// against the type they will be stored in.
+// Returns an error if value is not numeric or kind is not a numeric kind.
+func Fits(value any, kind reflect.Kind) (bool, error) {
+	value = Deref(value)
+	if NumberKindOf(value) == NonNumeric {
+		return false, fmt.Errorf("value of type %T is not a supported numeric type", value)
+	}
+
+	if isNonFinite(value) {
+		return kind == reflect.Float32 || kind == reflect.Float64, nil
+	}
+
+	r, err := ToRat(value)
+	if err != nil {
+		return false, err
+	}
+
+	switch kind {
+	case reflect.Float32:
+		f, _ := r.Float32()
+		return !math.IsInf(float64(f), 0), nil
+	case reflect.Float64:
+		f, _ := r.Float64()
+		return !math.IsInf(f, 0), nil
+	}
+
+	bits, signed, ok := intBits(kind)
+	if !ok {
+		return false, fmt.Errorf("%s is not a numeric kind", kind)
+	}
+
+	if !r.IsInt() {
+		return false, nil
+	}
+
+	n := r.Num()
+	if signed {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		return n.Cmp(new(big.Int).Neg(limit)) >= 0 && n.Cmp(limit) < 0, nil
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	return n.Sign() >= 0 && n.Cmp(limit) < 0, nil
+}
+
+// isNonFinite reports whether value is a float or *big.Float holding NaN or an infinity.
+func isNonFinite(value any) bool {
+	if f, ok := value.(big.Float); ok {
+		return f.IsInf()
+	}
+
+	v := reflect.ValueOf(value)
+	return isFloat(v) && (math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0))
+}
+
+// intBits returns the size in bits of an integer kind and whether it is signed.
+// Returns false if kind is not an integer kind.
+func intBits(kind reflect.Kind) (int, bool, bool) {
+	switch kind {
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		return strconv.IntSize, kind == reflect.Int, true
+	case reflect.Int8, reflect.Uint8:
+		return 8, kind == reflect.Int8, true
+	case reflect.Int16, reflect.Uint16:
+		return 16, kind == reflect.Int16, true
+	case reflect.Int32, reflect.Uint32:
+		return 32, kind == reflect.Int32, true
+	case reflect.Int64, reflect.Uint64:
+		return 64, kind == reflect.Int64, true
+	default:
+		return 0, false, false
+	}
+}
+
+// ParseDecimal parses a decimal string such as "42", "-0.5" or "1e9" into an exact
+// rational number, without the rounding of strconv.ParseFloat.
+// Returns false if s is not a decimal number.
+func ParseDecimal(s string) (*big.Rat, bool) {
+	if !decimalPattern.MatchString(s) {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+// NormalizeNumber returns numeric strings, such as form or query-string input, as a
+// json.Number so the numeric rules treat them as numbers: "42" and " -0.5 " become
+// json.Number("42") and json.Number("-0.5"). Only decimal integers and decimals are
+// accepted (see ParseDecimal), so "NaN", "Inf", "0x1F" and "1_000" are not numbers.
+// Other values are returned unchanged, after passing them through Deref.
+func NormalizeNumber(value any) any {
+	value = Deref(value)
+
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	s = strings.TrimSpace(s)
+	if !decimalPattern.MatchString(s) {
+		return value
+	}
+
+	return json.Number(s)
+}
+
+// ToRat converts a numeric value to an exact rational number. It accepts every
+// signed and unsigned integer kind, float32 and float64, json.Number, *big.Int,
+// *big.Float and *big.Rat, as well as pointers to them and driver.Valuer types
+// holding them (see Deref).
+// Returns an error for other types, malformed json.Number values, and infinite or NaN floats.
+func ToRat(value any) (*big.Rat, error) {
+	value = Deref(value)
+
+	switch n := value.(type) {
+	case json.Number:
+		r, ok := ParseDecimal(string(n))
+		if !ok {
+			return nil, fmt.Errorf("json.Number %q is not a decimal number", n)
+		}
+		return r, nil
+	case big.Int:
+		return new(big.Rat).SetInt(&n), nil
+	case big.Float:
+		if n.IsInf() {
+			return nil, fmt.Errorf("%v is not a finite number", &n)
+		}
+		r, _ := n.Rat(nil)
+		return r, nil
+	case big.Rat:
+		return new(big.Rat).Set(&n), nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetUint64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("%v is not a finite number", f)
+		}
+		return new(big.Rat).SetFloat64(f), nil
+	default:
+		return nil, fmt.Errorf("value of type %T is not a supported numeric type", value)
+	}
+}
+
+// CompareNumbers compares two numeric values, returning -1 if a < b, 0 if a == b
+// and +1 if a > b. Integers are compared as integers, so values beyond 2^53 are not
+// rounded, and signed and unsigned integers compare by value. A float is compared
+// exactly with any whole number, including an integral json.Number or *big.Int, but
+// a fractional decimal (json.Number, *big.Float or *big.Rat) is first rounded to the
+// float's precision, the way Go rounds a literal, so float64(9.99) equals the decimal
+// "9.99". Other combinations compare exactly.
+// Returns an error if either value is not numeric (see ToRat) or is NaN.
+func CompareNumbers(a, b any) (int, error) {
+	a, b = Deref(a), Deref(b)
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	switch {
+	case isSigned(av) && isSigned(bv):
+		return cmp.Compare(av.Int(), bv.Int()), nil
+	case isUnsigned(av) && isUnsigned(bv):
+		return cmp.Compare(av.Uint(), bv.Uint()), nil
+	case isSigned(av) && isUnsigned(bv):
+		if av.Int() < 0 {
+			return -1, nil
+		}
+		return cmp.Compare(uint64(av.Int()), bv.Uint()), nil
+	case isUnsigned(av) && isSigned(bv):
+		if bv.Int() < 0 {
+			return 1, nil
+		}
+		return cmp.Compare(av.Uint(), uint64(bv.Int())), nil
+	case isFloat(bv) && !isFloat(av):
+		c, err := CompareNumbers(b, a)
+		return -c, err
+	case isFloat(av):
+		return compareFloat(av, b)
+	}
+
+	aRat, err := ToRat(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bRat, err := ToRat(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return aRat.Cmp(bRat), nil
+}
+
+// compareFloat compares the float held by av with the numeric value b.
+func compareFloat(av reflect.Value, b any) (int, error) {
+	f := av.Float()
+	if math.IsNaN(f) {
+		return 0, fmt.Errorf("NaN is not comparable")
+	}
+
+	bv := reflect.ValueOf(b)
+	if isFloat(bv) {
+		if math.IsNaN(bv.Float()) {
+			return 0, fmt.Errorf("NaN is not comparable")
+		}
+		return cmp.Compare(f, bv.Float()), nil
+	}
+
+	bRat, err := ToRat(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if math.IsInf(f, 0) {
+		return int(math.Copysign(1, f)), nil
+	}
+
+	if isSigned(bv) || isUnsigned(bv) || bRat.IsInt() {
+		return new(big.Rat).SetFloat64(f).Cmp(bRat), nil
+	}
+
+	if av.Kind() == reflect.Float32 {
+		rounded, _ := bRat.Float32()
+		return cmp.Compare(f, float64(rounded)), nil
+	}
+
+	rounded, _ := bRat.Float64()
+	return cmp.Compare(f, rounded), nil
+}
+
+// isSigned reports whether v holds a signed integer.
+func isSigned(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isUnsigned reports whether v holds an unsigned integer.
+func isUnsigned(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFloat reports whether v holds a float.
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
@@ -0,0 +1,204 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestCompareNumbers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		name string
+		a, b any
+		want int
+	}{
+		{name: "ints beyond 2^53", a: int64(1<<53 + 1), b: int64(1 << 53), want: 1},
+		{name: "int and float beyond 2^53", a: int64(1<<53 + 1), b: float64(1 << 53), want: 1},
+		{name: "max uint64", a: uint64(math.MaxUint64), b: uint64(math.MaxUint64 - 1), want: 1},
+		{name: "uint64 and int64", a: uint64(math.MaxUint64), b: int64(math.MaxInt64), want: 1},
+		{name: "negative int and uint", a: -1, b: uint(0), want: -1},
+		{name: "int and float", a: 5, b: 5.0, want: 0},
+		{name: "float and decimal literal", a: 9.99, b: json.Number("9.99"), want: 0},
+		{name: "float32 and decimal literal", a: float32(0.1), b: json.Number("0.1"), want: 0},
+		{name: "json.Number beyond 2^53", a: json.Number("9007199254740993"), b: int64(1 << 53), want: 1},
+		{name: "json.Number decimals", a: json.Number("0.30000000000000001"), b: json.Number("0.3"), want: 1},
+		{name: "big.Int", a: huge, b: uint64(math.MaxUint64), want: 1},
+		{name: "big.Float", a: big.NewFloat(2.5), b: 2, want: 1},
+		{name: "big.Rat", a: big.NewRat(1, 3), b: json.Number("0.3333"), want: 1},
+		{name: "infinity", a: math.Inf(-1), b: int64(math.MinInt64), want: -1},
+		{name: "pointer", a: ptr(uint8(7)), b: 7, want: 0},
+		{name: "float and big.Int beyond 2^53", a: float64(1 << 53), b: big.NewInt(1<<53 + 1), want: -1},
+		{name: "float and integral json.Number beyond 2^53", a: float64(1 << 53), b: json.Number("9007199254740993"), want: -1},
+		{name: "float and integer literal beyond 2^53", a: float64(1 << 53), b: new(big.Rat).SetInt64(1<<53 + 1), want: -1},
+		{name: "float32 and big.Int", a: float32(1 << 24), b: big.NewInt(1<<24 + 1), want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validator.CompareNumbers(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("CompareNumbers(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareNumbersErrors(t *testing.T) {
+	for _, pair := range [][2]any{
+		{math.NaN(), 1},
+		{1, "1"},
+		{json.Number("1x"), 1},
+		{nil, 1},
+	} {
+		if _, err := validator.CompareNumbers(pair[0], pair[1]); err == nil {
+			t.Errorf("CompareNumbers(%v, %v): expected an error", pair[0], pair[1])
+		}
+	}
+}
+
+func TestExactNumericRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		rules    []string
+		wantCode string
+	}{
+		{name: "gt beyond 2^53", value: int64(1<<53 + 1), rules: []string{"gt:9007199254740992"}},
+		{name: "lt beyond 2^53", value: int64(1<<53 + 1), rules: []string{"lt:9007199254740992"}, wantCode: "lt"},
+		{name: "uint64 max", value: uint64(math.MaxUint64), rules: []string{"gt:18446744073709551614"}},
+		{name: "uint between", value: uint(5), rules: []string{"between:1,10"}},
+		{name: "json.Number", value: json.Number("1999"), rules: []string{"gt:1998", "lt:2000"}},
+		{name: "json.Number beyond 2^53", value: json.Number("9007199254740993"), rules: []string{"lt:9007199254740993"}, wantCode: "lt"},
+		{name: "big.Int", value: new(big.Int).Lsh(big.NewInt(1), 70), rules: []string{"gt:1180591620717411303423"}},
+		{name: "big.Float", value: big.NewFloat(0.5), rules: []string{"between:0.25,0.75"}},
+		{name: "float with decimal bound", value: 0.3, rules: []string{"gt:0.1", "lt:0.3"}, wantCode: "lt"},
+		{name: "float with integer bound beyond 2^53", value: float64(1 << 53), rules: []string{"lt:9007199254740993"}},
+		{name: "float equal to integer bound beyond 2^53", value: float64(1 << 53), rules: []string{"gt:9007199254740991", "lt:9007199254740992"}, wantCode: "lt"},
+		{name: "money in minor units", value: int64(9007199254740993), rules: []string{"max:9007199254740992"}, wantCode: "max.numeric"},
+		{name: "uint is numeric", value: uint32(7), rules: []string{"numeric"}},
+		{name: "same compares exactly", value: int64(1<<53 + 1), rules: []string{"same:other"}, wantCode: "same"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{"field": tt.value, "other": float64(1 << 53)}
+			v := validator.Make(data, map[string][]string{"field": tt.rules})
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestWholeNumberMessage(t *testing.T) {
+	v := validator.Make(map[string]any{"age": 18}, map[string][]string{"age": {"gt:17.5"}})
+	v.Validate()
+
+	if got := v.Errors()["age"]; len(got) != 1 || got[0] != "gt value 17.5 must be a whole number when age is an integer" {
+		t.Errorf("unexpected messages: %v", got)
+	}
+}
+
+func TestNumberKindOf(t *testing.T) {
+	tests := []struct {
+		value any
+		want  validator.NumberKind
+	}{
+		{value: 7, want: validator.IntegerKind},
+		{value: int8(-7), want: validator.IntegerKind},
+		{value: uint64(7), want: validator.IntegerKind},
+		{value: ptr(int32(7)), want: validator.IntegerKind},
+		{value: big.NewInt(7), want: validator.IntegerKind},
+		{value: json.Number("-7"), want: validator.IntegerKind},
+		{value: 7.0, want: validator.FloatKind},
+		{value: float32(7), want: validator.FloatKind},
+		{value: big.NewFloat(7), want: validator.FloatKind},
+		{value: json.Number("7.5"), want: validator.DecimalKind},
+		{value: json.Number("7e2"), want: validator.DecimalKind},
+		{value: big.NewRat(1, 2), want: validator.DecimalKind},
+		{value: json.Number("seven"), want: validator.NonNumeric},
+		{value: "7", want: validator.NonNumeric},
+		{value: nil, want: validator.NonNumeric},
+	}
+
+	for _, tt := range tests {
+		if got := validator.NumberKindOf(tt.value); got != tt.want {
+			t.Errorf("NumberKindOf(%#v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestIntegerKindsAreConsistent(t *testing.T) {
+	for _, value := range []any{int(18), int8(18), int16(18), int32(18), int64(18), uint(18), uint64(18), json.Number("18")} {
+		for _, rule := range []string{"gt:17.5", "lt:18.5", "between:17.5,19"} {
+			v := validator.Make(map[string]any{"age": value}, map[string][]string{"age": {rule}})
+			v.Validate()
+
+			name := strings.SplitN(rule, ":", 2)[0]
+			if errs := v.FieldErrors(); len(errs) != 1 || errs[0].Code != name+".whole_number" {
+				t.Errorf("%T with %s: expected a whole_number error, got: %v", value, rule, errs)
+			}
+		}
+
+		v := validator.Make(map[string]any{"age": value}, map[string][]string{"age": {"int", "numeric"}})
+		if !v.Validate() {
+			t.Errorf("%T: expected int and numeric to pass, got: %v", value, v.FieldErrors())
+		}
+	}
+}
+
+func TestFitsRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		rule     string
+		wantCode string
+	}{
+		{name: "json float within int16", value: 32767.0, rule: "fits:int16"},
+		{name: "json float above int16", value: 32768.0, rule: "fits:int16", wantCode: "fits"},
+		{name: "json float below int16", value: -32769.0, rule: "fits:int16", wantCode: "fits"},
+		{name: "fraction does not fit an integer", value: 1.5, rule: "fits:int64", wantCode: "fits"},
+		{name: "json.Number within uint64", value: json.Number("18446744073709551615"), rule: "fits:uint64"},
+		{name: "json.Number above uint64", value: json.Number("18446744073709551616"), rule: "fits:uint64", wantCode: "fits"},
+		{name: "negative does not fit unsigned", value: -1, rule: "fits:uint8", wantCode: "fits"},
+		{name: "int64 within int8", value: int64(-128), rule: "fits:int8"},
+		{name: "big.Int above int64", value: new(big.Int).Lsh(big.NewInt(1), 63), rule: "fits:int64", wantCode: "fits"},
+		{name: "decimal is rounded to float32", value: json.Number("0.1"), rule: "fits:float32"},
+		{name: "float64 above float32", value: 1e39, rule: "fits:float32", wantCode: "fits"},
+		{name: "infinity fits float64", value: math.Inf(1), rule: "fits:float64"},
+		{name: "infinity does not fit int", value: math.Inf(1), rule: "fits:int", wantCode: "fits"},
+		{name: "string is not numeric", value: "12", rule: "fits:int", wantCode: "fits.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(map[string]any{"field": tt.value}, map[string][]string{"field": {tt.rule}})
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestFitsRuleParams(t *testing.T) {
+	for _, expr := range []string{"fits", "fits:int128", "fits:int8,int16"} {
+		_, err := validator.Compile(map[string][]string{"n": {expr}})
+
+		var ruleErr *validator.RuleError
+		if !errors.As(err, &ruleErr) || ruleErr.Code != "fits.param" {
+			t.Errorf("%s: expected a fits.param error, got: %v", expr, err)
+		}
+	}
+}
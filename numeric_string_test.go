@@ -0,0 +1,65 @@
+package validator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestNumericStrings(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		rules    []string
+		wantCode string
+	}{
+		{name: "integer string is numeric", value: "17", rules: []string{"numeric"}},
+		{name: "decimal string is numeric", value: "-0.5", rules: []string{"numeric"}},
+		{name: "exponent string is numeric", value: "1e3", rules: []string{"numeric"}},
+		{name: "surrounding whitespace is ignored", value: " 17 ", rules: []string{"numeric", "int"}},
+		{name: "pointer to string", value: ptr("17"), rules: []string{"numeric", "int"}},
+		{name: "NaN is not numeric", value: "NaN", rules: []string{"numeric"}, wantCode: "numeric"},
+		{name: "Inf is not numeric", value: "-Inf", rules: []string{"numeric"}, wantCode: "numeric"},
+		{name: "hex is not numeric", value: "0x1F", rules: []string{"numeric"}, wantCode: "numeric"},
+		{name: "word is not numeric", value: "seventeen", rules: []string{"numeric"}, wantCode: "numeric"},
+		{name: "empty string is not numeric", value: "", rules: []string{"numeric"}, wantCode: "numeric"},
+		{name: "strict rejects strings", value: "17", rules: []string{"numeric:strict"}, wantCode: "numeric"},
+		{name: "strict accepts numbers", value: 17, rules: []string{"numeric:strict", "int:strict"}},
+		{name: "integer string is an int", value: "17", rules: []string{"int"}},
+		{name: "decimal string is not an int", value: "17.5", rules: []string{"int"}, wantCode: "int"},
+		{name: "strict int rejects strings", value: "17", rules: []string{"int:strict"}, wantCode: "int"},
+		{name: "gt on a string", value: "19", rules: []string{"gt:18"}},
+		{name: "gt on a string fails", value: "17", rules: []string{"gt:18"}, wantCode: "gt"},
+		{name: "lt on a decimal string", value: "9.99", rules: []string{"lt:10"}},
+		{name: "between on a string", value: "10", rules: []string{"between:9,11"}},
+		{name: "between on a string out of range", value: "12", rules: []string{"between:9,11"}, wantCode: "between"},
+		{name: "integer string needs a whole threshold", value: "18", rules: []string{"gt:17.5"}, wantCode: "gt.whole_number"},
+		{name: "string beyond 2^53 compares exactly", value: "9007199254740993", rules: []string{"gt:9007199254740992"}},
+		{name: "non-numeric string cannot be compared", value: "abc", rules: []string{"gt:18"}, wantCode: "gt.type"},
+		{name: "NaN string cannot be compared", value: "NaN", rules: []string{"lt:18"}, wantCode: "lt.type"},
+		{name: "string field reference", value: 20, rules: []string{"gt:min_age"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{"field": tt.value, "min_age": "18"}
+			v := validator.Make(data, map[string][]string{"field": tt.rules})
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestNumericModeParams(t *testing.T) {
+	for _, expr := range []string{"numeric:lenient", "int:strict,strict"} {
+		_, err := validator.Compile(map[string][]string{"n": {expr}})
+
+		var ruleErr *validator.RuleError
+		if !errors.As(err, &ruleErr) || (ruleErr.Code != "numeric.param" && ruleErr.Code != "int.param") {
+			t.Errorf("%s: expected a param error, got: %v", expr, err)
+		}
+	}
+}
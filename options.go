@@ -0,0 +1,19 @@
+package validator
+
+// Option configures a Validator created by Make or MakeStruct.
+type Option func(*Validator)
+
+// StopOnFirstFailure makes Validate stop after the first field that fails validation,
+// for cheaply rejecting obviously bad payloads. Only that field's errors are reported.
+func StopOnFirstFailure() Option {
+	return func(v *Validator) {
+		v.stopOnFirstFailure = true
+	}
+}
+
+// WithRegistry makes the Validator look rules up in r instead of the default registry.
+func WithRegistry(r *Registry) Option {
+	return func(v *Validator) {
+		v.registry = r
+	}
+}
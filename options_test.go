@@ -0,0 +1,88 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestBail(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		rules    map[string][]string
+		wantErrs int
+	}{
+		{
+			name:     "without bail every rule reports",
+			data:     map[string]any{"max_score": "eighty"},
+			rules:    map[string][]string{"max_score": {"int", "lt:81"}},
+			wantErrs: 2,
+		},
+		{
+			name:     "bail stops at first failure",
+			data:     map[string]any{"max_score": "eighty"},
+			rules:    map[string][]string{"max_score": {"bail", "int", "lt:81"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "bail position does not matter",
+			data:     map[string]any{"max_score": "eighty"},
+			rules:    map[string][]string{"max_score": {"int", "lt:81", "bail"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "bail with passing rules",
+			data:     map[string]any{"max_score": 80},
+			rules:    map[string][]string{"max_score": {"bail", "int", "lt:81"}},
+			wantErrs: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			v.Validate()
+
+			got := 0
+			for _, messages := range v.Errors() {
+				got += len(messages)
+			}
+
+			if got != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, got, v.Errors())
+			}
+		})
+	}
+}
+
+func TestStopOnFirstFailure(t *testing.T) {
+	data := map[string]any{
+		"name":  123,
+		"age":   "old",
+		"email": "not-an-email",
+	}
+
+	rules := map[string][]string{
+		"name":  {"string"},
+		"age":   {"int"},
+		"email": {"email"},
+	}
+
+	v := validator.Make(data, rules, validator.StopOnFirstFailure())
+	if v.Validate() {
+		t.Fatal("expected validation to fail")
+	}
+
+	if len(v.Errors()) != 1 {
+		t.Errorf("expected errors for exactly one field, got: %v", v.Errors())
+	}
+
+	v = validator.Make(data, rules)
+	v.Validate()
+
+	if len(v.Errors()) != 3 {
+		t.Errorf("expected errors for all fields without StopOnFirstFailure, got: %v", v.Errors())
+	}
+}
@@ -0,0 +1,119 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+var orderedSignup = []validator.FieldRules{
+	{Field: "username", Rules: []string{"required", "string"}},
+	{Field: "email", Rules: []string{"required", "email"}},
+	{Field: "age", Rules: []string{"required", "int"}},
+}
+
+func TestOrderedErrors(t *testing.T) {
+	data := map[string]any{"email": "rick", "age": "old"}
+	want := `{"username":["username is required"],"email":["email must be a valid email format (missing '@' or domain)"],"age":["age must be an integer"]}`
+
+	for i := 0; i < 20; i++ {
+		v := validator.MakeOrdered(data, orderedSignup)
+		v.Validate()
+
+		got, err := json.Marshal(v.OrderedErrors())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(got) != want {
+			t.Fatalf("run %d: expected %s, got %s", i, want, got)
+		}
+	}
+}
+
+func TestOrderedFieldErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(data map[string]any) validator.ValidationErrors
+		want     []string
+	}{
+		{
+			name: "ordered definition keeps declaration order",
+			validate: func(data map[string]any) validator.ValidationErrors {
+				v := validator.MakeOrdered(data, orderedSignup)
+				v.Validate()
+				return v.FieldErrors()
+			},
+			want: []string{"username", "email", "age"},
+		},
+		{
+			name: "rules map is validated by key",
+			validate: func(data map[string]any) validator.ValidationErrors {
+				v := validator.Make(data, map[string][]string{
+					"username": {"required"},
+					"email":    {"required"},
+					"age":      {"required"},
+				})
+				v.Validate()
+				return v.FieldErrors()
+			},
+			want: []string{"age", "email", "username"},
+		},
+		{
+			name: "compiled ordered schema keeps declaration order",
+			validate: func(data map[string]any) validator.ValidationErrors {
+				schema, err := validator.CompileOrdered(orderedSignup)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return schema.Validate(data)
+			},
+			want: []string{"username", "email", "age"},
+		},
+		{
+			name: "struct fields keep declaration order",
+			validate: func(map[string]any) validator.ValidationErrors {
+				signup := struct {
+					Username string `json:"username" validate:"required"`
+					Email    string `json:"email" validate:"required"`
+					Age      *int   `json:"age" validate:"required"`
+				}{}
+
+				v, err := validator.MakeStruct(signup)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				v.Validate()
+				return v.FieldErrors()
+			},
+			want: []string{"username", "email", "age"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				var got []string
+				for _, fm := range tt.validate(map[string]any{}).Ordered() {
+					got = append(got, fm.Field)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("run %d: expected fields %v, got %v", i, tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorsFields(t *testing.T) {
+	errs := validator.Errors{"name": {"a"}, "age": {"b"}, "items.0.price": {"c"}}
+
+	want := []string{"age", "items.0.price", "name"}
+	if got := errs.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
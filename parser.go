@@ -0,0 +1,233 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Characters with a special meaning in rule expressions.
+const (
+	// ruleDelimiter separates the rules of an expression (e.g. "string|min:5").
+	ruleDelimiter = '|'
+
+	// paramsDelimiter separates a rule name from its parameters (e.g. "min:5").
+	paramsDelimiter = ':'
+
+	// paramDelimiter separates the parameters of a rule (e.g. "between:1,10").
+	paramDelimiter = ','
+
+	// quote encloses a parameter containing special characters (e.g. `regex:"^a|b$"`).
+	quote = '"'
+
+	// escape makes the next special character literal (e.g. `in:a\,b`).
+	escape = '\\'
+)
+
+// ParsedRule is a single rule of a parsed rule expression.
+type ParsedRule struct {
+	// Name is the rule name, e.g. "between".
+	Name string
+
+	// Params holds the rule's parameters with quotes and escapes removed, e.g. ["1", "10"].
+	Params []string
+
+	// Expr is the source text of the rule, e.g. "between:1,10".
+	Expr string
+
+	// Column is the 1-based column, in characters, where the rule starts in the expression.
+	Column int
+}
+
+// SyntaxError describes a malformed rule expression.
+type SyntaxError struct {
+	// Expr is the rule expression that failed to parse.
+	Expr string
+
+	// Column is the 1-based column, in characters, of the offending character.
+	Column int
+
+	// Msg describes the problem, e.g. "unterminated quoted parameter".
+	Msg string
+}
+
+// Error returns the description of the problem with its position.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error in rule expression %q at column %d: %s", e.Expr, e.Column, e.Msg)
+}
+
+// ParseRules parses a rule expression into its rules. The grammar is:
+//
+//	expression = rule { "|" rule }
+//	rule       = name [ ":" param { "," param } ]
+//
+// so "string|min:5|between:1,10" is three rules, and "between" gets the params
+// ["1", "10"]. Whitespace around names and unquoted params is ignored.
+//
+// A param containing ',', '|' or '"' is either enclosed in double quotes, inside
+// which '\"' and '\\' are the only escapes (e.g. `regex:"^(a|b),c$"`), or written with
+// each such character escaped by a backslash (e.g. `in:a\,b`). Other backslashes are
+// kept as they are, so regular expressions such as `\d+` need no extra escaping.
+//
+// Returns a *SyntaxError if the expression is malformed.
+func ParseRules(expr string) ([]ParsedRule, error) {
+	p := &ruleParser{expr: expr, src: []rune(expr)}
+
+	var parsed []ParsedRule
+	for {
+		rule, err := p.parseRule()
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, rule)
+
+		if p.done() {
+			return parsed, nil
+		}
+
+		// parseRule stops only at the end of the expression or at a rule delimiter.
+		p.pos++
+	}
+}
+
+// ruleParser holds the state of parsing one rule expression.
+type ruleParser struct {
+	expr string
+	src  []rune
+	pos  int
+}
+
+// parseRule parses a rule name and its optional parameters.
+func (p *ruleParser) parseRule() (ParsedRule, error) {
+	p.skipSpace()
+	start := p.pos
+
+	for !p.done() && p.peek() != paramsDelimiter && p.peek() != ruleDelimiter {
+		c := p.peek()
+		if c == paramDelimiter || c == quote || c == escape {
+			return ParsedRule{}, p.errorf(p.pos, "unexpected %q in rule name", c)
+		}
+		p.pos++
+	}
+
+	name := strings.TrimSpace(string(p.src[start:p.pos]))
+	if name == "" {
+		return ParsedRule{}, p.errorf(start, "expected rule name")
+	}
+
+	if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+		return ParsedRule{}, p.errorf(start+len([]rune(name[:i])), "unexpected space in rule name")
+	}
+
+	rule := ParsedRule{Name: name, Column: start + 1}
+
+	if p.peek() == paramsDelimiter {
+		p.pos++
+
+		for {
+			param, err := p.parseParam()
+			if err != nil {
+				return ParsedRule{}, err
+			}
+			rule.Params = append(rule.Params, param)
+
+			if p.peek() != paramDelimiter {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	rule.Expr = strings.TrimSpace(string(p.src[start:p.pos]))
+	return rule, nil
+}
+
+// parseParam parses a quoted or unquoted parameter, stopping before the next
+// parameter or rule delimiter.
+func (p *ruleParser) parseParam() (string, error) {
+	p.skipSpace()
+	if p.peek() == quote {
+		return p.parseQuoted()
+	}
+
+	var b strings.Builder
+	for !p.done() {
+		c := p.peek()
+
+		switch {
+		case c == paramDelimiter || c == ruleDelimiter:
+			return strings.TrimSpace(b.String()), nil
+		case c == quote:
+			return "", p.errorf(p.pos, "unexpected '\"' in unquoted parameter; quote the whole parameter or escape it")
+		case c == escape && p.pos+1 < len(p.src) && isSpecial(p.src[p.pos+1]):
+			p.pos++
+			c = p.peek()
+		}
+
+		b.WriteRune(c)
+		p.pos++
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// parseQuoted parses a parameter enclosed in double quotes.
+func (p *ruleParser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++
+
+	var b strings.Builder
+	for !p.done() {
+		c := p.peek()
+
+		switch {
+		case c == quote:
+			p.pos++
+			p.skipSpace()
+
+			if !p.done() && p.peek() != paramDelimiter && p.peek() != ruleDelimiter {
+				return "", p.errorf(p.pos, "expected ',' or '|' after quoted parameter")
+			}
+			return b.String(), nil
+		case c == escape && p.pos+1 < len(p.src) && (p.src[p.pos+1] == quote || p.src[p.pos+1] == escape):
+			p.pos++
+			c = p.peek()
+		}
+
+		b.WriteRune(c)
+		p.pos++
+	}
+
+	return "", p.errorf(start, "unterminated quoted parameter")
+}
+
+// done reports whether the whole expression has been consumed.
+func (p *ruleParser) done() bool {
+	return p.pos >= len(p.src)
+}
+
+// peek returns the current character, or 0 at the end of the expression.
+func (p *ruleParser) peek() rune {
+	if p.done() {
+		return 0
+	}
+
+	return p.src[p.pos]
+}
+
+// skipSpace advances past whitespace.
+func (p *ruleParser) skipSpace() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// errorf returns a SyntaxError for the character at pos.
+func (p *ruleParser) errorf(pos int, format string, args ...any) error {
+	return &SyntaxError{Expr: p.expr, Column: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// isSpecial reports whether c has a special meaning inside a parameter.
+func isSpecial(c rune) bool {
+	return c == paramDelimiter || c == ruleDelimiter || c == quote || c == escape
+}
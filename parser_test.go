@@ -0,0 +1,151 @@
+package validator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestParseRules(t *testing.T) {
+	type rule struct {
+		name   string
+		params []string
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want []rule
+	}{
+		{name: "single rule", expr: "string", want: []rule{{"string", nil}}},
+		{name: "pipes", expr: "string|min:5|max:20", want: []rule{{"string", nil}, {"min", []string{"5"}}, {"max", []string{"20"}}}},
+		{name: "comma-separated params", expr: "between:1,10", want: []rule{{"between", []string{"1", "10"}}}},
+		{name: "whitespace is ignored", expr: " required | between: 1 , 10 ", want: []rule{{"required", nil}, {"between", []string{"1", "10"}}}},
+		{name: "colons after the first are literal", expr: "after:10:30", want: []rule{{"after", []string{"10:30"}}}},
+		{name: "empty param", expr: "confirmed:", want: []rule{{"confirmed", []string{""}}}},
+		{name: "quoted param keeps delimiters", expr: `regex:"^(a|b),c$"|string`, want: []rule{{"regex", []string{"^(a|b),c$"}}, {"string", nil}}},
+		{name: "quoted param keeps whitespace", expr: `in:" a ",b`, want: []rule{{"in", []string{" a ", "b"}}}},
+		{name: "escaped quote in quoted param", expr: `in:"say \"hi\"",x`, want: []rule{{"in", []string{`say "hi"`, "x"}}}},
+		{name: "escaped delimiters in unquoted param", expr: `in:a\,b,c\|d`, want: []rule{{"in", []string{"a,b", "c|d"}}}},
+		{name: "other backslashes are kept", expr: `regex:^\d+\.\d*$`, want: []rule{{"regex", []string{`^\d+\.\d*$`}}}},
+		{name: "multibyte params", expr: "in:नेपाल,日本", want: []rule{{"in", []string{"नेपाल", "日本"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := validator.ParseRules(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []rule
+			for _, pr := range parsed {
+				got = append(got, rule{pr.Name, pr.Params})
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRules(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRulesSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		expr       string
+		wantColumn int
+		wantMsg    string
+	}{
+		{expr: "", wantColumn: 1, wantMsg: "expected rule name"},
+		{expr: "string|", wantColumn: 8, wantMsg: "expected rule name"},
+		{expr: "string||min:5", wantColumn: 8, wantMsg: "expected rule name"},
+		{expr: ":5", wantColumn: 1, wantMsg: "expected rule name"},
+		{expr: "min 5", wantColumn: 4, wantMsg: "unexpected space in rule name"},
+		{expr: "min,5", wantColumn: 4, wantMsg: `unexpected ',' in rule name`},
+		{expr: `string|regex:"^a`, wantColumn: 14, wantMsg: "unterminated quoted parameter"},
+		{expr: `in:"a"b`, wantColumn: 7, wantMsg: "expected ',' or '|' after quoted parameter"},
+		{expr: `in:a"b"`, wantColumn: 5, wantMsg: `unexpected '"' in unquoted parameter; quote the whole parameter or escape it`},
+		{expr: `in:"नेपाल`, wantColumn: 4, wantMsg: "unterminated quoted parameter"},
+		{expr: `in:नेपाल"`, wantColumn: 9, wantMsg: `unexpected '"' in unquoted parameter; quote the whole parameter or escape it`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := validator.ParseRules(tt.expr)
+
+			var syntaxErr *validator.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a SyntaxError, got: %v", err)
+			}
+
+			if syntaxErr.Column != tt.wantColumn || syntaxErr.Msg != tt.wantMsg {
+				t.Errorf("got column %d %q, want column %d %q", syntaxErr.Column, syntaxErr.Msg, tt.wantColumn, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestRuleExpressions(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		rules    map[string][]string
+		wantCode string
+	}{
+		{
+			name:  "pipe-separated rules pass",
+			data:  map[string]any{"username": "rickastley"},
+			rules: map[string][]string{"username": {"required|string|min:5|max:20"}},
+		},
+		{
+			name:     "pipe-separated rules fail",
+			data:     map[string]any{"username": "rick"},
+			rules:    map[string][]string{"username": {"required|string|min:5|max:20"}},
+			wantCode: "min",
+		},
+		{
+			name:     "presence rules inside pipes",
+			data:     map[string]any{},
+			rules:    map[string][]string{"username": {"bail|required|string"}},
+			wantCode: validator.CodeRequired,
+		},
+		{
+			name:     "quoted param with comma",
+			data:     map[string]any{"account_type": "business, ltd"},
+			rules:    map[string][]string{"company_name": {`required_if:account_type,"business, ltd"`}},
+			wantCode: "required_if",
+		},
+		{
+			name:     "malformed expression is a field error",
+			data:     map[string]any{"username": "rickastley"},
+			rules:    map[string][]string{"username": {"string|"}},
+			wantCode: validator.CodeInvalidRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestCompileSyntaxError(t *testing.T) {
+	_, err := validator.Compile(map[string][]string{"code": {`string|regex:"^a`}})
+
+	var syntaxErr *validator.SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Column != 14 {
+		t.Fatalf("expected a SyntaxError at column 14, got: %v", err)
+	}
+
+	want := `validator: syntax error in rule expression "string|regex:\"^a" at column 14: unterminated quoted parameter`
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
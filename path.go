@@ -0,0 +1,271 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// pathSeparator separates segments of a nested field path (e.g. "address.city").
+const pathSeparator = "."
+
+// wildcard matches every element of a slice, array or map in a field path (e.g. "items.*.price").
+const wildcard = "*"
+
+// expandPath resolves a field pattern against the data into concrete field paths.
+// Wildcard segments are replaced by the index or key of every element they match,
+// so "items.*.price" becomes "items.0.price", "items.1.price", and so on.
+// Patterns without wildcards are returned unchanged, even if the path does not exist.
+func expandPath(d data, pattern string) []string {
+	if _, ok := d[pattern]; ok || !strings.Contains(pattern, wildcard) {
+		return []string{pattern}
+	}
+
+	segments := strings.Split(pattern, pathSeparator)
+	if segments[0] == wildcard {
+		keys := make([]string, 0, len(d))
+		for key := range d {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var paths []string
+		for _, key := range keys {
+			paths = append(paths, expandSegments(d[key], key, segments[1:])...)
+		}
+		return paths
+	}
+
+	return expandSegments(d[segments[0]], segments[0], segments[1:])
+}
+
+// expandSegments expands the remaining segments of a pattern below value,
+// prefixing every resulting path with prefix.
+func expandSegments(value any, prefix string, segments []string) []string {
+	if len(segments) == 0 {
+		return []string{prefix}
+	}
+
+	segment, rest := segments[0], segments[1:]
+	if segment != wildcard {
+		child, _ := lookupSegment(value, segment)
+		return expandSegments(child, prefix+pathSeparator+segment, rest)
+	}
+
+	rv := indirect(reflect.ValueOf(value))
+
+	var paths []string
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			child := valueOf(rv.Index(i))
+			paths = append(paths, expandSegments(child, prefix+pathSeparator+strconv.Itoa(i), rest)...)
+		}
+	case reflect.Map:
+		if !isPathKey(rv.Type().Key()) {
+			break
+		}
+
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return lessKey(keys[i], keys[j])
+		})
+
+		for _, key := range keys {
+			child := valueOf(rv.MapIndex(key))
+			paths = append(paths, expandSegments(child, prefix+pathSeparator+fmt.Sprint(key.Interface()), rest)...)
+		}
+	}
+
+	return paths
+}
+
+// NewData wraps a map so it can be passed to DataAwareRule.ValidateData
+// outside of a Validator. Field paths are resolved the same way as in Make.
+func NewData(values map[string]any) Data {
+	return data(values)
+}
+
+// Get returns the value at a field path and true if it exists.
+// Paths use the same dot notation as rule keys (e.g. "address.city").
+func (d data) Get(path string) (any, bool) {
+	return lookupPath(d, path)
+}
+
+// ResolvePath resolves a path referenced by a rule on field, replacing each '*'
+// in path with the segment at the same position in field. A rule on "items.3.price"
+// referencing "items.*.currency" therefore reads "items.3.currency".
+func ResolvePath(field, path string) string {
+	if !strings.Contains(path, wildcard) {
+		return path
+	}
+
+	fieldSegments := strings.Split(field, pathSeparator)
+	segments := strings.Split(path, pathSeparator)
+
+	for i, segment := range segments {
+		if segment == wildcard && i < len(fieldSegments) {
+			segments[i] = fieldSegments[i]
+		}
+	}
+
+	return strings.Join(segments, pathSeparator)
+}
+
+// lookupPath returns the value at a concrete field path such as "items.3.price".
+// A key that exactly matches the path takes precedence over nested lookup.
+// Returns the value and true if every segment of the path exists, otherwise nil and false.
+func lookupPath(d data, path string) (any, bool) {
+	if value, ok := d[path]; ok {
+		return value, true
+	}
+
+	segments := strings.Split(path, pathSeparator)
+
+	value, ok := d[segments[0]]
+	if !ok {
+		return nil, false
+	}
+
+	for _, segment := range segments[1:] {
+		value, ok = lookupSegment(value, segment)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// lookupSegment returns the child of value named by a single path segment.
+// Maps are indexed by key (converted to the map's string or integer key type),
+// slices and arrays by position, and structs by
+// `json` tag name or Go field name.
+func lookupSegment(value any, segment string) (any, bool) {
+	rv := indirect(reflect.ValueOf(value))
+
+	switch rv.Kind() {
+	case reflect.Map:
+		key, ok := mapKey(rv.Type().Key(), segment)
+		if !ok {
+			return nil, false
+		}
+
+		child := rv.MapIndex(key)
+		if !child.IsValid() {
+			return nil, false
+		}
+		return valueOf(child), true
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= rv.Len() {
+			return nil, false
+		}
+		return valueOf(rv.Index(i)), true
+	case reflect.Struct:
+		child, ok := structField(rv, segment)
+		if !ok {
+			return nil, false
+		}
+		return valueOf(child), true
+	default:
+		return nil, false
+	}
+}
+
+// isPathKey reports whether map keys of type t can be named by a path segment:
+// strings, and signed and unsigned integers written in decimal.
+func isPathKey(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// mapKey converts a path segment to a map key of type t, so "1" names the key 1
+// of a map[int]any. Returns false if t is not a path key type (see isPathKey) or
+// the segment is not a valid key of that type.
+func mapKey(t reflect.Type, segment string) (reflect.Value, bool) {
+	key := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.String:
+		key.SetString(segment)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(segment, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(segment, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetUint(n)
+	default:
+		return reflect.Value{}, false
+	}
+
+	return key, true
+}
+
+// lessKey reports whether the map key a sorts before b, both of a path key type
+// (see isPathKey). Integer keys sort numerically, so 2 comes before 10.
+func lessKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	default:
+		return a.String() < b.String()
+	}
+}
+
+// structField finds the exported field reported under name, promoting the fields
+// of embedded structs the same way MakeStruct flattens them (see visibleFields).
+func structField(rv reflect.Value, name string) (reflect.Value, bool) {
+	for _, f := range visibleFields(rv.Type()) {
+		if f.name != name {
+			continue
+		}
+
+		fv, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return fv, true
+	}
+
+	return reflect.Value{}, false
+}
+
+// indirect unwraps interfaces and non-nil pointers around rv.
+func indirect(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+
+	return rv
+}
+
+// valueOf returns the value held by rv, dereferencing pointers.
+// Nil pointers, nil interfaces and unexported values are returned as nil.
+func valueOf(rv reflect.Value) any {
+	rv = indirect(rv)
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil
+	}
+
+	return rv.Interface()
+}
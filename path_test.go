@@ -0,0 +1,207 @@
+package validator_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestNestedPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       map[string]any
+		rules      map[string][]string
+		wantFields []string
+	}{
+		{
+			name: "dotted path into nested map passes",
+			data: map[string]any{
+				"address": map[string]any{"city": "Kathmandu"},
+			},
+			rules: map[string][]string{
+				"address.city": {"string", "min:3"},
+			},
+		},
+		{
+			name: "dotted path into nested map fails",
+			data: map[string]any{
+				"address": map[string]any{"city": 977},
+			},
+			rules: map[string][]string{
+				"address.city": {"string"},
+			},
+			wantFields: []string{"address.city"},
+		},
+		{
+			name: "literal dotted key takes precedence",
+			data: map[string]any{
+				"address.city": "Pokhara",
+			},
+			rules: map[string][]string{
+				"address.city": {"string"},
+			},
+		},
+		{
+			name: "wildcard reports concrete indexes",
+			data: map[string]any{
+				"items": []any{
+					map[string]any{"price": 10},
+					map[string]any{"price": -1},
+					map[string]any{"price": 5},
+					map[string]any{"price": 0},
+				},
+			},
+			rules: map[string][]string{
+				"items.*.price": {"numeric", "gt:0"},
+			},
+			wantFields: []string{"items.1.price", "items.3.price"},
+		},
+		{
+			name: "wildcard over map values",
+			data: map[string]any{
+				"scores": map[string]any{"rick": 90, "astley": "ninety"},
+			},
+			rules: map[string][]string{
+				"scores.*": {"int"},
+			},
+			wantFields: []string{"scores.astley"},
+		},
+		{
+			name: "nested wildcards",
+			data: map[string]any{
+				"orders": []map[string]any{
+					{"lines": []map[string]any{{"qty": 1}, {"qty": "one"}}},
+				},
+			},
+			rules: map[string][]string{
+				"orders.*.lines.*.qty": {"int"},
+			},
+			wantFields: []string{"orders.0.lines.1.qty"},
+		},
+		{
+			name: "wildcard over integer map keys",
+			data: map[string]any{
+				"ratings": map[int]any{1: "one", 2: 5},
+			},
+			rules: map[string][]string{
+				"ratings.*": {"required", "int"},
+			},
+			wantFields: []string{"ratings.1"},
+		},
+		{
+			name: "dotted path into unsigned map keys",
+			data: map[string]any{
+				"ports": map[uint16]any{8080: "http"},
+			},
+			rules: map[string][]string{
+				"ports.8080": {"int"},
+				"ports.443":  {"required"},
+				"ports.x":    {"required"},
+			},
+			wantFields: []string{"ports.443", "ports.8080", "ports.x"},
+		},
+		{
+			name: "wildcard skips maps with keys a path cannot name",
+			data: map[string]any{
+				"weights": map[float64]any{0.5: "half"},
+			},
+			rules: map[string][]string{
+				"weights.*": {"int"},
+			},
+		},
+		{
+			name: "wildcard over empty slice validates nothing",
+			data: map[string]any{
+				"items": []any{},
+			},
+			rules: map[string][]string{
+				"items.*.price": {"numeric"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			v.Validate()
+
+			var got []string
+			for field := range v.Errors() {
+				got = append(got, field)
+			}
+			sort.Strings(got)
+
+			if len(got) != len(tt.wantFields) || (len(got) > 0 && !reflect.DeepEqual(got, tt.wantFields)) {
+				t.Errorf("expected errors for %v, got: %v", tt.wantFields, v.Errors())
+			}
+		})
+	}
+}
+
+type Address struct {
+	City string `json:"city" validate:"string|min:3"`
+}
+
+type LineItem struct {
+	Price float64 `json:"price" validate:"numeric|gt:0.5"`
+}
+
+type Order struct {
+	Address  Address     `json:"address"`
+	Billing  *Address    `json:"billing"`
+	Items    []LineItem  `json:"items"`
+	Children []*LineItem `json:"children"`
+}
+
+func TestWildcardSortsIntegerMapKeys(t *testing.T) {
+	v := validator.Make(
+		map[string]any{
+			"ratings": map[int]any{10: "ten", 2: "two", -1: "minus one", 1: "one"},
+			"ports":   map[uint16]any{8080: "http", 443: "https", 80: "http"},
+		},
+		map[string][]string{
+			"ratings.*": {"int"},
+			"ports.*":   {"int"},
+		},
+	)
+	v.Validate()
+
+	var got []string
+	for _, fe := range v.FieldErrors() {
+		got = append(got, fe.Field)
+	}
+
+	want := []string{"ports.80", "ports.443", "ports.8080", "ratings.-1", "ratings.1", "ratings.2", "ratings.10"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestValidateStructNested(t *testing.T) {
+	order := Order{
+		Address:  Address{City: "KT"},
+		Billing:  &Address{City: "Lalitpur"},
+		Items:    []LineItem{{Price: 10.5}, {Price: 0.1}},
+		Children: []*LineItem{{Price: 0.2}},
+	}
+
+	errs, err := validator.ValidateStruct(order)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"address.city", "children.0.price", "items.1.price"}
+
+	var got []string
+	for field := range errs {
+		got = append(got, field)
+	}
+	sort.Strings(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected errors for %v, got: %v", want, errs)
+	}
+}
@@ -0,0 +1,92 @@
+package validator
+
+// Presence rule names. These rules are handled by the validator itself rather than
+// the rule registry, because they depend on whether a field exists in the data and
+// not only on its value.
+const (
+	// ruleRequired fails if the field is missing or empty.
+	ruleRequired = "required"
+
+	// ruleNullable allows the field to be nil, skipping the rest of its rules.
+	ruleNullable = "nullable"
+
+	// ruleSometimes only validates the field when it is present in the data.
+	ruleSometimes = "sometimes"
+
+	// rulePresent fails if the field is missing, but allows it to be empty.
+	rulePresent = "present"
+
+	// ruleFilled fails if the field is present and empty, but allows it to be missing.
+	ruleFilled = "filled"
+)
+
+// presence holds the presence rules declared for a field.
+type presence struct {
+	required  bool
+	nullable  bool
+	sometimes bool
+	present   bool
+	filled    bool
+}
+
+// parsePresence extracts the presence rules from a field's rules.
+// It returns the declared presence rules and the remaining rules.
+func parsePresence(parsed []ParsedRule) (presence, []ParsedRule) {
+	var p presence
+	remaining := make([]ParsedRule, 0, len(parsed))
+
+	for _, pr := range parsed {
+		switch pr.Name {
+		case ruleRequired:
+			p.required = true
+		case ruleNullable:
+			p.nullable = true
+		case ruleSometimes:
+			p.sometimes = true
+		case rulePresent:
+			p.present = true
+		case ruleFilled:
+			p.filled = true
+		default:
+			remaining = append(remaining, pr)
+		}
+	}
+
+	return p, remaining
+}
+
+// checkPresence applies the presence rules to a field and records any failures.
+// exists reports whether the field was found in the data.
+// Returns false if a presence rule failed, which stops the field's rule chain
+// so a missing field produces a single message, or if the field is optional
+// ("sometimes") and missing.
+func (v *Validator) checkPresence(pattern, field string, value any, exists bool, p presence) bool {
+	if p.sometimes && !exists {
+		return false
+	}
+
+	if p.required && (!exists || IsEmpty(value)) {
+		v.fail(pattern, field, ruleRequired, nil, value, NewError(field, CodeRequired, ":attribute is required", nil))
+		return false
+	}
+
+	if p.present && !exists {
+		v.fail(pattern, field, rulePresent, nil, value, NewError(field, CodePresent, ":attribute must be present", nil))
+		return false
+	}
+
+	if p.filled && exists && IsEmpty(value) {
+		v.fail(pattern, field, ruleFilled, nil, value, NewError(field, CodeFilled, ":attribute must not be empty when present", nil))
+		return false
+	}
+
+	return true
+}
+
+// implicitOnly reports whether only implicit rules should run against a field.
+// Missing fields are skipped unless an implicit rule requires them, and nil values
+// (including nil pointers and invalid sql.Null* values) are skipped when the
+// field is nullable.
+func (p presence) implicitOnly(value any, exists bool) bool {
+	return !exists || (p.nullable && IsNil(Deref(value)))
+}
@@ -0,0 +1,135 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestPresenceRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		rules    map[string][]string
+		wantErrs int
+	}{
+		{
+			name:     "missing optional field is skipped",
+			data:     map[string]any{},
+			rules:    map[string][]string{"age": {"int", "gt:18"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "required missing field produces one message",
+			data:     map[string]any{},
+			rules:    map[string][]string{"age": {"required", "int", "gt:18"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "required empty string fails",
+			data:     map[string]any{"username": "   "},
+			rules:    map[string][]string{"username": {"required", "string"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "required nil fails",
+			data:     map[string]any{"username": nil},
+			rules:    map[string][]string{"username": {"required", "string"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "required empty slice fails",
+			data:     map[string]any{"tags": []string{}},
+			rules:    map[string][]string{"tags": {"required"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "required present value runs chain",
+			data:     map[string]any{"age": 16},
+			rules:    map[string][]string{"age": {"required", "int", "gt:18"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "nullable accepts nil",
+			data:     map[string]any{"age": nil},
+			rules:    map[string][]string{"age": {"nullable", "int", "gt:18"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "nullable accepts typed nil pointer",
+			data:     map[string]any{"age": (*int)(nil)},
+			rules:    map[string][]string{"age": {"nullable", "int"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "nullable still validates non-nil value",
+			data:     map[string]any{"age": "old"},
+			rules:    map[string][]string{"age": {"nullable", "int"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "sometimes skips missing field",
+			data:     map[string]any{},
+			rules:    map[string][]string{"email": {"sometimes", "required", "email"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "sometimes validates present field",
+			data:     map[string]any{"email": ""},
+			rules:    map[string][]string{"email": {"sometimes", "required", "email"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "present fails when missing",
+			data:     map[string]any{},
+			rules:    map[string][]string{"bio": {"present"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "present allows empty value",
+			data:     map[string]any{"bio": ""},
+			rules:    map[string][]string{"bio": {"present"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "filled allows missing field",
+			data:     map[string]any{},
+			rules:    map[string][]string{"bio": {"filled", "string"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "filled fails on empty value",
+			data:     map[string]any{"bio": ""},
+			rules:    map[string][]string{"bio": {"filled", "string"}},
+			wantErrs: 1,
+		},
+		{
+			name: "required applies to each wildcard element",
+			data: map[string]any{
+				"items": []any{
+					map[string]any{"price": 10},
+					map[string]any{},
+				},
+			},
+			rules:    map[string][]string{"items.*.price": {"required", "numeric"}},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules)
+			v.Validate()
+
+			got := 0
+			for _, messages := range v.Errors() {
+				got += len(messages)
+			}
+
+			if got != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, got, v.Errors())
+			}
+		})
+	}
+}
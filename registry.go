@@ -2,29 +2,160 @@ package validator
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 )
 
-// ruleRegistry holds all registered validation rules by their name.
-var ruleRegistry = make(map[string]Rule)
+// Registry holds validation rules by their name.
+// Validators look rules up in the default registry unless given another one
+// with WithRegistry, so independent registries can hold rules with the same name.
+// A Registry is safe for concurrent use, so rules can be registered while
+// other goroutines are validating.
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]Rule
+}
 
-// RegisterRule adds a new rule implementation to the global registry.
-// It panics if a rule with the same name has already been registered.
-// Typically called in init() functions inside rule packages.
-func RegisterRule(rule Rule) {
+// defaultRegistry holds the rules registered with RegisterRule,
+// including the built-in rules once the rules package is imported.
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates a registry holding the given rules.
+// Use NewRegistry(rules.Builtins()...) to opt in to the built-in rules,
+// or DefaultRegistry().Clone() to start from every globally registered rule.
+// It panics if two rules share a name.
+func NewRegistry(rules ...Rule) *Registry {
+	r := &Registry{rules: make(map[string]Rule, len(rules))}
+
+	for _, rule := range rules {
+		if err := r.Register(rule); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	return r
+}
+
+// DefaultRegistry returns the global registry used by RegisterRule and GetRule.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds a rule implementation to the registry.
+// Returns an error if a rule with the same name has already been registered.
+func (r *Registry) Register(rule Rule) error {
 	name := rule.Name()
 
-	_, exists := ruleRegistry[name]
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exists := r.rules[name]
 	if exists {
-		panic(fmt.Sprintf("rule '%s' is already registered", name))
+		return fmt.Errorf("rule '%s' is already registered", name)
 	}
 
-	ruleRegistry[rule.Name()] = rule
+	r.rules[name] = rule
+
+	return nil
 }
 
-// GetRule retrieves a rule implementation by its name.
+// Get retrieves a rule implementation by its name.
 // Returns the rule and true if found, otherwise returns false.
-func GetRule(name string) (Rule, bool) {
-	rule, ok := ruleRegistry[name]
+func (r *Registry) Get(name string) (Rule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rule, ok := r.rules[name]
 
 	return rule, ok
 }
+
+// Clone returns a copy of the registry. Rules registered on the copy
+// do not affect the original, and vice versa.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clone := &Registry{rules: make(map[string]Rule, len(r.rules))}
+	for name, rule := range r.rules {
+		clone.rules[name] = rule
+	}
+
+	return clone
+}
+
+// Replace adds a rule implementation to the registry, intentionally overriding
+// any rule already registered under the same name.
+// Returns the replaced rule and true if there was one.
+func (r *Registry) Replace(rule Rule) (Rule, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := rule.Name()
+	previous, existed := r.rules[name]
+	r.rules[name] = rule
+
+	return previous, existed
+}
+
+// Unregister removes the rule with the given name from the registry.
+// Returns true if a rule was removed.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exists := r.rules[name]
+	delete(r.rules, name)
+
+	return exists
+}
+
+// Rules returns every rule in the registry, sorted by name.
+func (r *Registry) Rules() []Rule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]Rule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		list = append(list, rule)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name() < list[j].Name()
+	})
+
+	return list
+}
+
+// RegisterRule adds a new rule implementation to the global registry.
+// It panics if a rule with the same name has already been registered.
+// Typically called in init() functions inside rule packages.
+func RegisterRule(rule Rule) {
+	if err := defaultRegistry.Register(rule); err != nil {
+		panic(err.Error())
+	}
+}
+
+// GetRule retrieves a rule implementation by its name from the global registry.
+// Returns the rule and true if found, otherwise returns false.
+func GetRule(name string) (Rule, bool) {
+	return defaultRegistry.Get(name)
+}
+
+// ReplaceRule adds a rule implementation to the global registry, intentionally
+// overriding any rule already registered under the same name.
+// Returns the replaced rule and true if there was one.
+func ReplaceRule(rule Rule) (Rule, bool) {
+	return defaultRegistry.Replace(rule)
+}
+
+// UnregisterRule removes the rule with the given name from the global registry.
+// Returns true if a rule was removed.
+func UnregisterRule(name string) bool {
+	return defaultRegistry.Unregister(name)
+}
+
+// Rules returns every rule in the global registry, sorted by name.
+func Rules() []Rule {
+	return defaultRegistry.Rules()
+}
@@ -0,0 +1,150 @@
+package validator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	"github.com/shivajichalise/validator/rules"
+)
+
+type slugRule struct {
+	sep string
+}
+
+func (r slugRule) Name() string {
+	return "slug"
+}
+
+func (r slugRule) Validate(field string, value any, _ ...string) error {
+	str, _ := value.(string)
+	if str == "" || strings.Trim(str, "abcdefghijklmnopqrstuvwxyz0123456789"+r.sep) != "" {
+		return validator.NewError(field, "slug", ":attribute must be a slug", nil)
+	}
+
+	return nil
+}
+
+func TestRegistryIsolation(t *testing.T) {
+	dashes := validator.NewRegistry(rules.Builtins()...)
+	if err := dashes.Register(slugRule{sep: "-"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	underscores := validator.DefaultRegistry().Clone()
+	if err := underscores.Register(slugRule{sep: "_"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := map[string]any{"slug": "never-gonna"}
+	fieldRules := map[string][]string{"slug": {"string", "slug"}}
+
+	if v := validator.Make(data, fieldRules, validator.WithRegistry(dashes)); !v.Validate() {
+		t.Errorf("expected dash slug to pass, got: %v", v.Errors())
+	}
+
+	if v := validator.Make(data, fieldRules, validator.WithRegistry(underscores)); v.Validate() {
+		t.Error("expected dash slug to fail with underscore registry")
+	}
+
+	if _, ok := validator.GetRule("slug"); ok {
+		t.Error("expected slug rule not to leak into the default registry")
+	}
+
+	v := validator.Make(data, fieldRules)
+	if v.Validate() || v.FieldErrors()[0].Code != validator.CodeUnknownRule {
+		t.Errorf("expected unknown rule with default registry, got: %v", v.Errors())
+	}
+
+	if err := dashes.Register(slugRule{}); err == nil {
+		t.Error("expected error registering a duplicate rule")
+	}
+}
+
+func TestEmptyRegistry(t *testing.T) {
+	v := validator.Make(
+		map[string]any{"name": "rick"},
+		map[string][]string{"name": {"string"}},
+		validator.WithRegistry(validator.NewRegistry()),
+	)
+
+	if v.Validate() {
+		t.Error("expected built-in rule to be missing from an empty registry")
+	}
+}
+
+func TestBuiltinsAreRegistered(t *testing.T) {
+	for _, rule := range rules.Builtins() {
+		if _, ok := validator.GetRule(rule.Name()); !ok {
+			t.Errorf("built-in rule %q is not registered with the default registry", rule.Name())
+		}
+	}
+}
+
+func TestRegistryReplaceUnregisterAndList(t *testing.T) {
+	reg := validator.NewRegistry(rules.Builtins()...)
+
+	if _, replaced := reg.Replace(slugRule{sep: "-"}); replaced {
+		t.Error("expected Replace of a new rule to report no previous rule")
+	}
+
+	previous, replaced := reg.Replace(slugRule{sep: "_"})
+	if !replaced || previous.(slugRule).sep != "-" {
+		t.Errorf("expected Replace to return the previous rule, got: %v, %v", previous, replaced)
+	}
+
+	rule, _ := reg.Get("slug")
+	if rule.(slugRule).sep != "_" {
+		t.Errorf("expected replaced rule, got: %v", rule)
+	}
+
+	list := reg.Rules()
+	if len(list) != len(rules.Builtins())+1 {
+		t.Errorf("expected %d rules, got %d", len(rules.Builtins())+1, len(list))
+	}
+
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Name() >= list[i].Name() {
+			t.Errorf("expected rules sorted by name, got %q before %q", list[i-1].Name(), list[i].Name())
+		}
+	}
+
+	if !reg.Unregister("slug") {
+		t.Error("expected Unregister to remove the rule")
+	}
+
+	if reg.Unregister("slug") {
+		t.Error("expected second Unregister to report nothing removed")
+	}
+
+	if _, ok := reg.Get("slug"); ok {
+		t.Error("expected slug rule to be gone")
+	}
+}
+
+func TestRegistryConcurrentUse(t *testing.T) {
+	reg := validator.NewRegistry(rules.Builtins()...)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			reg.Replace(slugRule{sep: "-"})
+			reg.Rules()
+			reg.Unregister("slug")
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		v := validator.Make(
+			map[string]any{"name": "rickastley"},
+			map[string][]string{"name": {"string", "min:5"}},
+			validator.WithRegistry(reg),
+		)
+		if !v.Validate() {
+			t.Fatalf("unexpected errors: %v", v.Errors())
+		}
+	}
+
+	<-done
+}
@@ -1,5 +1,7 @@
 package validator
 
+import "context"
+
 // Rule defines the interface for all validation rules.
 // Each rule must have a unique name and implement custom validation logic.
 type Rule interface {
@@ -9,7 +11,76 @@ type Rule interface {
 
 	// Validate runs the validation logic for the given field and value.
 	// The params slice contains any optional arguments passed with the rule
-	// (e.g., "min:5" would pass "5" as params[0]).
+	// (e.g., "min:5" would pass "5" as params[0], and "between:1,10" would
+	// pass "1" and "10"). See ParseRules for how expressions are parsed.
 	// It returns an error if the validation fails.
 	Validate(field string, value any, params ...string) error
 }
+
+// Data gives rules read access to the whole payload under validation.
+type Data interface {
+	// Get returns the value at a field path (e.g. "account_type" or "items.3.price")
+	// and true if it exists, otherwise nil and false.
+	Get(path string) (any, bool)
+}
+
+// DataAwareRule is an optional extension of Rule for rules that depend on other
+// fields of the payload, such as "required_if" or "same".
+// When a rule implements it, the validator calls ValidateData instead of Validate.
+type DataAwareRule interface {
+	Rule
+
+	// ValidateData runs the validation logic for the given field and value,
+	// with data giving access to the rest of the payload.
+	ValidateData(data Data, field string, value any, params ...string) error
+}
+
+// ContextRule is an optional extension of Rule for rules that do I/O, such as DNS or
+// database lookups, or that depend on request-scoped values.
+// When a rule implements it, ValidateContext on the Validator or Schema calls the rule's
+// ValidateContext instead of Validate or ValidateData; Validate passes context.Background().
+// The payload is available from the context with DataFromContext.
+type ContextRule interface {
+	Rule
+
+	// ValidateContext runs the validation logic for the given field and value.
+	// It should stop waiting on I/O when ctx is done.
+	ValidateContext(ctx context.Context, field string, value any, params ...string) error
+}
+
+// dataKey is the context key under which the payload is passed to context-aware rules.
+type dataKey struct{}
+
+// withData returns a copy of ctx carrying the payload under validation.
+func withData(ctx context.Context, d data) context.Context {
+	return context.WithValue(ctx, dataKey{}, NewData(d))
+}
+
+// DataFromContext returns the payload under validation, for context-aware rules
+// that also depend on other fields. Returns false if ctx does not carry one.
+func DataFromContext(ctx context.Context) (Data, bool) {
+	d, ok := ctx.Value(dataKey{}).(Data)
+	return d, ok
+}
+
+// ImplicitRule is an optional extension of Rule for rules that must run even when
+// the field is missing from the data or nil in a nullable field, such as "required_with".
+// A failing implicit rule stops the rest of the field's rule chain.
+type ImplicitRule interface {
+	Rule
+
+	// Implicit reports whether the rule runs for missing fields.
+	Implicit() bool
+}
+
+// ParamRule is an optional extension of Rule for rules that can check their
+// parameters without a value, such as "min" requiring a number.
+// Compile calls CheckParams so malformed rule expressions are reported before
+// any data is validated.
+type ParamRule interface {
+	Rule
+
+	// CheckParams returns an error if params are not valid for the rule.
+	// field is the rules key the rule is declared on, for use in the message.
+	CheckParams(field string, params ...string) error
+}
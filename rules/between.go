@@ -1,15 +1,14 @@
 package rules
 
 import (
-	"fmt"
-	"reflect"
-	"strconv"
+	"math/big"
 	"strings"
 
 	"github.com/shivajichalise/validator"
 )
 
 // BetweenRule validates that a numeric field lies strictly between two specified values.
+// Either bound may be a number or the name of another field.
 type BetweenRule struct{}
 
 func init() {
@@ -21,56 +20,84 @@ func (r BetweenRule) Name() string {
 	return "between"
 }
 
-// Validate checks whether the given numeric value lies strictly between two thresholds.
-// The thresholds must be passed as a single comma-separated parameter (e.g., "between:1,10").
+// CheckParams checks that both bounds are given (e.g., "between:1,10").
+// Whether a field reference exists can only be checked against a payload.
+func (r BetweenRule) CheckParams(field string, params ...string) error {
+	if len(params) != 2 || strings.TrimSpace(params[0]) == "" || strings.TrimSpace(params[1]) == "" {
+		return validator.NewError(field, "between.param", ":attribute: between rule requires two comma-separated values in the format 'min,max'", nil)
+	}
+
+	return nil
+}
+
+// Validate checks the value against numeric bounds (e.g., "between:1,10").
+// Field references cannot be resolved without a payload; use ValidateData for those.
+func (r BetweenRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the given numeric value lies strictly between two thresholds.
+// The thresholds must be passed as two parameters (e.g., "between:1,10"),
+// and either of them may name another field instead (e.g., "between:min_price,max_price").
+// Numeric strings such as "17" are compared as numbers (see validator.NormalizeNumber).
 // Returns an error if:
 // - the parameter is missing or incorrectly formatted
 // - the value is not numeric
 // - the value is not strictly between the provided thresholds
-// - the field is an integer and thresholds are not whole numbers
-// - the field is a float and thresholds are not precise enough (e.g., both bounds are integers)
-func (r BetweenRule) Validate(field string, value any, params ...string) error {
-	if len(params) != 1 {
-		return fmt.Errorf("%s: between rule requires a single parameter in the format 'min,max'", field)
+// - the field is an integer and literal thresholds are not whole numbers
+// - the field is a float and literal thresholds are not precise enough (e.g., both bounds are integers)
+func (r BetweenRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
 	}
 
-	parts := strings.Split(params[0], ",")
-	if len(parts) != 2 {
-		return fmt.Errorf("%s: between rule requires two comma-separated values", field)
+	min, ok := resolveOperand(data, field, params[0])
+	if !ok {
+		return validator.NewError(field, "between.param", ":attribute: lower cap must be a valid number or field name", nil)
 	}
 
-	min, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
-	if err != nil {
-		return fmt.Errorf("%s: lower cap must be a valid number", field)
+	max, ok := resolveOperand(data, field, params[1])
+	if !ok {
+		return validator.NewError(field, "between.param", ":attribute: upper cap must be a valid number or field name", nil)
 	}
 
-	max, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
-	if err != nil {
-		return fmt.Errorf("%s: upper cap must be a valid number", field)
+	value = validator.NormalizeNumber(value)
+
+	kind := validator.NumberKindOf(value)
+	if kind == validator.NonNumeric {
+		return validator.NewError(field, "between.type", ":attribute must be numeric to use between (apply 'numeric', 'int', or 'float64' rule first)", nil)
+	}
+
+	if min.literal && max.literal {
+		minVal, maxVal := min.value.(*big.Rat), max.value.(*big.Rat)
+
+		switch kind {
+		case validator.IntegerKind:
+			if !minVal.IsInt() {
+				return validator.NewError(field, "between.whole_number", "between value :value must be a whole number when :attribute is an integer", validator.Args{"value": min.label})
+			}
+			if !maxVal.IsInt() {
+				return validator.NewError(field, "between.whole_number", "between value :value must be a whole number when :attribute is an integer", validator.Args{"value": max.label})
+			}
+		case validator.FloatKind:
+			if minVal.IsInt() && maxVal.IsInt() {
+				return validator.NewError(field, "between.precision", ":attribute: float fields must use at least one decimal bound in between rule", nil)
+			}
+		}
 	}
 
-	val, err := validator.ToFloat64(value)
+	lower, err := compareValues(value, min.value)
 	if err != nil {
-		return fmt.Errorf("%s must be numeric to use between (apply 'numeric', 'int', or 'float64' rule first)", field)
+		return validator.NewError(field, "between.incomparable", ":attribute and :other must both be numeric to use between", validator.Args{"other": min.label})
 	}
 
-	kind := reflect.TypeOf(value).Kind()
-	switch kind {
-	case reflect.Int:
-		if !validator.IsWholeNumber(min) {
-			return fmt.Errorf("between value %.2f must be a whole number when %s is an integer", min, field)
-		}
-		if !validator.IsWholeNumber(max) {
-			return fmt.Errorf("between value %.2f must be a whole number when %s is an integer", max, field)
-		}
-	case reflect.Float32, reflect.Float64:
-		if validator.IsWholeNumber(min) && validator.IsWholeNumber(max) {
-			return fmt.Errorf("%s: float fields must use at least one decimal bound in between rule", field)
-		}
+	upper, err := compareValues(value, max.value)
+	if err != nil {
+		return validator.NewError(field, "between.incomparable", ":attribute and :other must both be numeric to use between", validator.Args{"other": max.label})
 	}
 
-	if !(min < val && val < max) {
-		return fmt.Errorf("%s must be between %v and %v", field, min, max)
+	if lower <= 0 || upper >= 0 {
+		return validator.NewError(field, "between", ":attribute must be between :min and :max", validator.Args{"min": min.label, "max": max.label})
 	}
 
 	return nil
@@ -1,8 +1,6 @@
 package rules
 
 import (
-	"fmt"
-
 	"github.com/shivajichalise/validator"
 )
 
@@ -27,7 +25,7 @@ func (r BooleanRule) Name() string {
 //
 // It returns an error if the value doesn't match any supported boolean form.
 func (r BooleanRule) Validate(field string, value any, _ ...string) error {
-	switch v := value.(type) {
+	switch v := validator.Deref(value).(type) {
 	case bool:
 		return nil
 	case string:
@@ -40,5 +38,5 @@ func (r BooleanRule) Validate(field string, value any, _ ...string) error {
 		}
 	}
 
-	return fmt.Errorf("%s must be a boolean value (true, false, 1, 0)", field)
+	return validator.NewError(field, "boolean", ":attribute must be a boolean value (true, false, 1, 0)", nil)
 }
@@ -0,0 +1,34 @@
+package rules
+
+import "github.com/shivajichalise/validator"
+
+// Builtins returns every built-in rule, for building a registry that opts in to them
+// explicitly, e.g. validator.NewRegistry(rules.Builtins()...).
+// Importing this package also registers them with the default registry.
+func Builtins() []validator.Rule {
+	return []validator.Rule{
+		BetweenRule{},
+		BooleanRule{},
+		ConfirmedRule{},
+		DifferentRule{},
+		EmailRule{},
+		EmailDomainRule{},
+		FitsRule{},
+		Float64Rule{},
+		GtRule{},
+		IntRule{},
+		LtRule{},
+		MaxRule{},
+		MinRule{},
+		NumericRule{},
+		RequiredIfRule{},
+		RequiredUnlessRule{},
+		RequiredWithRule{},
+		RequiredWithAllRule{},
+		RequiredWithoutRule{},
+		RequiredWithoutAllRule{},
+		SameRule{},
+		SizeRule{},
+		StringRule{},
+	}
+}
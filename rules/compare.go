@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shivajichalise/validator"
+)
+
+// operand is one side of a comparison resolved from a rule parameter.
+type operand struct {
+	value   any    // the numeric literal (a *big.Rat) or the referenced field's value
+	label   string // how the operand is named in error messages
+	literal bool   // whether the parameter was a numeric literal
+}
+
+// resolveOperand resolves a rule parameter into a comparison operand.
+// Decimal parameters are used as exact literals (e.g., "gt:10" or "lt:0.1"); any other
+// parameter is treated as the path of another field in data (e.g., "gt:min_price"), with
+// wildcards resolved against field; numeric strings held by that field are treated as
+// numbers (see validator.NormalizeNumber). Returns false if the parameter is neither a
+// number nor an existing field.
+func resolveOperand(data validator.Data, field, param string) (operand, bool) {
+	param = strings.TrimSpace(param)
+
+	if num, ok := validator.ParseDecimal(param); ok {
+		return operand{value: num, label: param, literal: true}, true
+	}
+
+	value, ok := otherValue(data, field, param)
+	if !ok {
+		return operand{}, false
+	}
+
+	return operand{value: validator.NormalizeNumber(value), label: param}, true
+}
+
+// dateLayouts are the layouts of strings compared as dates: RFC 3339 timestamps, as
+// encoded by encoding/json for time.Time, and calendar dates such as "2026-01-31".
+var dateLayouts = []string{time.RFC3339Nano, time.DateOnly}
+
+// compareValues compares a and b, returning -1 if a < b, 0 if a == b and 1 if a > b.
+// Both values must be numeric, or both must be dates (see toTime). Numbers are
+// compared exactly (see validator.CompareNumbers).
+// Returns an error if the values cannot be compared.
+func compareValues(a, b any) (int, error) {
+	a, b = validator.Deref(a), validator.Deref(b)
+
+	aTime, aIsTime := toTime(a)
+	bTime, bIsTime := toTime(b)
+	if aIsTime || bIsTime {
+		if !aIsTime || !bIsTime {
+			return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+		}
+		return aTime.Compare(bTime), nil
+	}
+
+	return validator.CompareNumbers(a, b)
+}
+
+// toTime returns value as a date: a time.Time, or a string in one of dateLayouts.
+// Date-only strings are midnight UTC.
+func toTime(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// equalValues reports whether a and b hold the same value.
+// Numeric values are compared exactly by value regardless of their Go type,
+// so 5 equals 5.0 and uint64(5); all other values must be deeply equal.
+func equalValues(a, b any) bool {
+	a, b = validator.Deref(a), validator.Deref(b)
+
+	if validator.NumberKindOf(a) != validator.NonNumeric && validator.NumberKindOf(b) != validator.NonNumeric {
+		cmp, err := compareValues(a, b)
+		return err == nil && cmp == 0
+	}
+
+	return reflect.DeepEqual(a, b)
+}
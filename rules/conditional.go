@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shivajichalise/validator"
+)
+
+// conditionalRule holds the behaviour shared by the conditional requirement rules
+// (required_if, required_unless, required_with, ...). These rules are implicit,
+// so they run even when the field is missing from the data.
+type conditionalRule struct{}
+
+// Implicit reports that conditional requirement rules run for missing fields.
+func (conditionalRule) Implicit() bool {
+	return true
+}
+
+// trimParams returns the rule parameters with surrounding whitespace removed.
+// Returns nil if no parameter was given.
+func trimParams(params []string) []string {
+	if len(params) == 0 || (len(params) == 1 && strings.TrimSpace(params[0]) == "") {
+		return nil
+	}
+
+	trimmed := make([]string, len(params))
+	for i, param := range params {
+		trimmed[i] = strings.TrimSpace(param)
+	}
+
+	return trimmed
+}
+
+// otherValue returns the value of another field referenced by a rule on field.
+// Wildcards in other are resolved against field (see validator.ResolvePath).
+func otherValue(data validator.Data, field, other string) (any, bool) {
+	return data.Get(validator.ResolvePath(field, other))
+}
+
+// isFilled reports whether another field is present and not empty.
+func isFilled(data validator.Data, field, other string) bool {
+	value, ok := otherValue(data, field, other)
+	return ok && !validator.IsEmpty(value)
+}
+
+// matchesAny reports whether value, compared in its string form, equals any of the candidates.
+// Nil values match the candidate "null".
+func matchesAny(value any, candidates []string) bool {
+	str := "null"
+	if value = validator.Deref(value); value != nil {
+		str = fmt.Sprint(value)
+	}
+
+	for _, candidate := range candidates {
+		if str == candidate {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"github.com/shivajichalise/validator"
+)
+
+// confirmationSuffix is appended to a field's path to find its confirmation field.
+const confirmationSuffix = "_confirmation"
+
+// ConfirmedRule validates that a field matches its confirmation field.
+// By default the confirmation field is "<field>_confirmation" (e.g., "password_confirmation"),
+// and "confirmed:other" names a different one.
+type ConfirmedRule struct{}
+
+func init() {
+	validator.RegisterRule(ConfirmedRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "confirmed").
+func (r ConfirmedRule) Name() string {
+	return "confirmed"
+}
+
+// Validate runs the rule without access to other fields, so the confirmation is treated as missing.
+// Use ValidateData to compare against a payload.
+func (r ConfirmedRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the value equals the value of its confirmation field.
+// Returns an error if the confirmation is missing or does not match.
+func (r ConfirmedRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	other := field + confirmationSuffix
+	if len(params) > 0 && params[0] != "" {
+		other = params[0]
+	}
+
+	otherVal, ok := otherValue(data, field, other)
+	if !ok || !equalValues(value, otherVal) {
+		return validator.NewError(field, "confirmed", ":attribute must be confirmed by a matching :other", validator.Args{"other": other})
+	}
+
+	return nil
+}
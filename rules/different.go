@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/shivajichalise/validator"
+)
+
+// DifferentRule validates that a field has a different value from another field.
+// Use "different:other" in rule expressions.
+type DifferentRule struct{}
+
+func init() {
+	validator.RegisterRule(DifferentRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "different").
+func (r DifferentRule) Name() string {
+	return "different"
+}
+
+// CheckParams checks that the other field is given (e.g., "different:password").
+func (r DifferentRule) CheckParams(field string, params ...string) error {
+	if len(params) == 0 || strings.TrimSpace(params[0]) == "" {
+		return validator.NewError(field, "different.param", ":attribute: different rule requires a field to compare with", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the other field is treated as missing.
+// Use ValidateData to compare against a payload.
+func (r DifferentRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the value differs from the value of the other field
+// (e.g., "different:old_password"). Numeric values are compared by value.
+// Returns an error if the parameter is missing or the values are equal.
+func (r DifferentRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	other := params[0]
+	otherVal, _ := otherValue(data, field, other)
+
+	if equalValues(value, otherVal) {
+		return validator.NewError(field, "different", ":attribute and :other must be different", validator.Args{"other": other})
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package rules
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// disposableDomainsFile holds the built-in list of disposable email domains.
+//
+//go:embed disposable_domains.txt
+var disposableDomainsFile string
+
+// DisposableDomains lists the domains of disposable email services, rejected by the
+// email rule's "nodisposable" mode. It starts with the built-in list; add domains at
+// startup, before validating, from a file or in code:
+//
+//	if err := rules.DisposableDomains.LoadFile("disposable_domains.txt"); err != nil {
+//	    log.Fatal(err)
+//	}
+//	rules.DisposableDomains.Add("throwaway.example")
+var DisposableDomains = mustParseDomainList(disposableDomainsFile)
+
+// DomainList is a set of domain names that also matches their subdomains: a list
+// holding "mailinator.com" contains "eu.mailinator.com", but not "notmailinator.com".
+// Domains are matched case-insensitively and in their ASCII form, so "bücher.de" and
+// "xn--bcher-kva.de" are the same domain.
+//
+// The zero value is an empty list, and a nil *DomainList contains no domains.
+// A DomainList is safe for concurrent use.
+type DomainList struct {
+	mu      sync.RWMutex
+	domains map[string]bool
+}
+
+// NewDomainList returns a list holding the given domains.
+func NewDomainList(domains ...string) *DomainList {
+	l := &DomainList{}
+	l.Add(domains...)
+	return l
+}
+
+// Add adds domains to the list. A leading "*." or "." is ignored, so "*.example.com"
+// is the same as "example.com", which already matches its subdomains.
+// Domains that cannot be converted to their ASCII form are skipped.
+func (l *DomainList) Add(domains ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.domains == nil {
+		l.domains = make(map[string]bool, len(domains))
+	}
+
+	for _, domain := range domains {
+		if canonical, ok := canonicalDomain(domain); ok {
+			l.domains[canonical] = true
+		}
+	}
+}
+
+// Contains reports whether domain, or any domain it is a subdomain of, is in the list.
+func (l *DomainList) Contains(domain string) bool {
+	if l == nil {
+		return false
+	}
+
+	domain, ok := canonicalDomain(domain)
+	if !ok {
+		return false
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for {
+		if l.domains[domain] {
+			return true
+		}
+
+		dot := strings.IndexByte(domain, '.')
+		if dot < 0 {
+			return false
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+// Len returns the number of domains in the list.
+func (l *DomainList) Len() int {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.domains)
+}
+
+// Load reads domains from r, one per line, and adds them to the list. Blank lines
+// and lines starting with '#' are ignored.
+// Returns an error, without adding any domain, if a line is not a domain name.
+func (l *DomainList) Load(r io.Reader) error {
+	domains, err := parseDomains(r)
+	if err != nil {
+		return err
+	}
+
+	l.Add(domains...)
+	return nil
+}
+
+// LoadFile reads domains from the named file, in the format of Load, and adds them
+// to the list.
+func (l *DomainList) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := l.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
+
+// parseDomains reads the domains of a list, one per line.
+func parseDomains(r io.Reader) ([]string, error) {
+	var domains []string
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if _, ok := canonicalDomain(text); !ok {
+			return nil, fmt.Errorf("rules: invalid domain %q on line %d", text, line)
+		}
+		domains = append(domains, text)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// mustParseDomainList parses the domains of a built-in list, panicking if it is malformed.
+func mustParseDomainList(list string) *DomainList {
+	domains, err := parseDomains(strings.NewReader(list))
+	if err != nil {
+		panic(err)
+	}
+
+	return NewDomainList(domains...)
+}
+
+// canonicalDomain returns the lowercase ASCII form of a domain, without a leading
+// "*." or "." or a trailing dot, as stored in a DomainList.
+// Returns false if domain is empty, contains spaces or '@', or cannot be converted.
+func canonicalDomain(domain string) (string, bool) {
+	domain = strings.TrimPrefix(strings.TrimSpace(domain), "*.")
+	domain = normalizeDomain(strings.TrimPrefix(domain, "."))
+
+	if domain == "" || strings.ContainsAny(domain, " \t@") {
+		return "", false
+	}
+
+	ascii, err := toASCII(domain)
+	if err != nil {
+		return "", false
+	}
+
+	return ascii, true
+}
@@ -1,29 +1,77 @@
 package rules
 
 import (
-	"fmt"
-	"net"
+	"context"
+	"errors"
 	"net/mail"
 	"regexp"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/shivajichalise/validator"
 )
 
 // EmailRule validates whether a value is a properly formatted email address.
-// It supports multiple modes: basic format check, RFC-compliant syntax, and DNS MX lookup.
-type EmailRule struct{}
+// It supports multiple modes: basic format check, RFC-compliant syntax, strict
+// syntax, internationalized addresses, spoofing detection, disposable domains and
+// DNS lookups.
+//
+// The zero value looks domains up with net.DefaultResolver. To use another resolver,
+// such as FakeResolver in tests, or another timeout, replace the registered rule:
+//
+//	validator.ReplaceRule(rules.EmailRule{Resolver: resolver, Timeout: 2 * time.Second})
+type EmailRule struct {
+	// Resolver looks up MX, A and AAAA records for the "dns" mode.
+	// If nil, net.DefaultResolver is used.
+	Resolver Resolver
+
+	// Timeout bounds the DNS lookups of each address. If zero, DefaultDNSTimeout is used.
+	Timeout time.Duration
+
+	// Disposable lists the domains rejected by the "nodisposable" mode.
+	// If nil, DisposableDomains is used.
+	Disposable *DomainList
+
+	// Allow lists domains accepted by the "nodisposable" mode even if they are
+	// in Disposable or Block, e.g. a partner's domain on the built-in list.
+	Allow *DomainList
+
+	// Block lists further domains rejected by the "nodisposable" mode.
+	Block *DomainList
+}
+
+// Length limits of an email address from RFC 5321, in bytes.
+const (
+	maxEmailLength      = 254 // the whole address, as usable in a forward path
+	maxEmailLocalLength = 64  // the local part, before the '@'
+	maxDomainLength     = 253 // the domain name in its ASCII form
+	maxLabelLength      = 63  // each label of the domain name
+)
 
 // emailValidationMode controls which levels of email validation are enabled.
 type emailValidationMode struct {
-	basicOnly bool // Perform only a simple format check
-	checkRFC  bool // Enable RFC-compliant syntax validation
-	checkDNS  bool // Perform MX record lookup for domain
+	checkRFC     bool // Enable RFC-compliant syntax validation instead of the basic format check
+	checkStrict  bool // Reject quoted local parts, address literals and malformed host names
+	checkSpoof   bool // Reject local parts and domain labels mixing scripts
+	noDisposable bool // Reject domains of disposable email services
+	checkDNS     bool // Check that the domain has MX records, or A/AAAA records as a fallback
+	allowIDN     bool // Accept non-ASCII characters in the local part and domain
+	allowDisplay bool // Accept addresses with a display name, e.g. "Rick <rick@astley.com>"
 }
 
 // basicEmailRegex is used for simple format validation when no advanced checks are enabled.
 var basicEmailRegex *regexp.Regexp
 
+// hostnameLabelRegex matches a label of a host name: letters, digits and hyphens,
+// not starting or ending with a hyphen.
+var hostnameLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+// idnDomainRegex matches the ASCII form of a domain name in the basic format check
+// of internationalized addresses, where the top-level domain may be punycode.
+var idnDomainRegex = regexp.MustCompile(`^[a-zA-Z0-9.\-]+\.([a-zA-Z]{2,}|xn--[a-zA-Z0-9\-]+)$`)
+
 func init() {
 	validator.RegisterRule(EmailRule{})
 
@@ -35,72 +83,250 @@ func (r EmailRule) Name() string {
 	return "email"
 }
 
+// CheckParams checks that every parameter is a known mode (e.g., "email:rfc,dns").
+func (r EmailRule) CheckParams(field string, params ...string) error {
+	_, err := parseEmailMode(field, params)
+	return err
+}
+
 // Validate performs email validation on the given field value based on the selected mode.
-// Supported modes (via params):
-//   - "rfc": enables RFC-compliant syntax check
-//   - "dns": enables MX record lookup on domain
+// Supported modes (via params), which can be combined:
+//   - "rfc": checks RFC-compliant syntax instead of the basic format
+//   - "strict": like "rfc", but also rejects quoted local parts, address literals
+//     such as "rick@[192.0.2.1]", and domains that are not valid host names
+//   - "idn": accepts internationalized addresses, such as "rick@bücher.de"; the domain
+//     is checked and looked up in its punycode form ("xn--bcher-kva.de")
+//   - "spoof": rejects local parts and domain labels mixing scripts, such as a Cyrillic
+//     "а" among Latin letters, which are used to imitate other addresses
+//   - "nodisposable": rejects domains of disposable email services and their
+//     subdomains, as listed by the rule's Disposable, Allow and Block lists
+//   - "dns": checks that the domain has MX records, or A/AAAA records when it has none,
+//     and that it does not publish a null MX record (RFC 7505) refusing all email
+//   - "display_name": accepts a display name, as in "Rick <rick@astley.com>"
 //
-// If no parameters are provided, only the basic format is validated.
+// If no parameters are provided, only the basic format is validated, and "dns" alone
+// checks the basic format before looking the domain up. Display names are rejected
+// unless allowed, and every mode enforces the RFC 5321 limits of 64 bytes for the
+// local part and 254 bytes for the whole address. Non-ASCII addresses are rejected
+// by the basic and strict checks unless "idn" is given.
+// DNS lookups are bounded by the rule's Timeout; validate with ValidateContext to
+// also bound them by a caller's deadline or cancellation.
 func (r EmailRule) Validate(field string, value any, params ...string) error {
-	str, ok := value.(string)
+	return r.ValidateContext(context.Background(), field, value, params...)
+}
+
+// ValidateContext is like Validate, but DNS lookups are also cancelled when ctx is done.
+// It is called by Validator.ValidateContext and Schema.ValidateContext (see validator.ContextRule).
+// A lookup that is cancelled or times out fails with the code "email.dns_unavailable".
+func (r EmailRule) ValidateContext(ctx context.Context, field string, value any, params ...string) error {
+	mode, err := parseEmailMode(field, params)
+	if err != nil {
+		return err
+	}
+
+	str, ok := validator.Deref(value).(string)
 	if !ok {
-		return fmt.Errorf("%s field must be a valid string", field)
+		return validator.NewError(field, "email.type", ":attribute field must be a valid string", nil)
 	}
 
 	if strings.TrimSpace(str) == "" {
-		return fmt.Errorf("%s must not be empty", field)
+		return validator.NewError(field, "email.empty", ":attribute must not be empty", nil)
 	}
 
-	mode := parseEmailMode(params)
+	// 1. Display names, e.g. "Rick <rick@astley.com>"
+	address := str
+	if isDisplayForm(str) {
+		if !mode.allowDisplay {
+			return validator.NewError(field, "email.display_name", ":attribute must be a plain email address without a display name", nil)
+		}
 
-	// 1. Basic format validation using regex
-	if mode.basicOnly {
-		if !basicEmailRegex.MatchString(str) {
-			return fmt.Errorf("%s must be a valid email format (missing '@' or domain)", field)
+		addr, err := mail.ParseAddress(str)
+		if err != nil {
+			return validator.NewError(field, "email", ":attribute must be a valid email format (missing '@' or domain)", nil)
 		}
-		return nil
+		address = addr.Address
 	}
 
-	addr, err := mail.ParseAddress(str)
+	// 2. Internationalized addresses, e.g. "rick@bücher.de"
+	if !mode.allowIDN && !isASCII(address) && (mode.checkStrict || !mode.checkRFC) {
+		return validator.NewError(field, "email.idn", ":attribute must not contain international characters", nil)
+	}
 
-	// 2. RFC-compliant email validation
+	// 3. RFC-compliant, strict or basic format validation
 	if mode.checkRFC {
-		if err != nil {
-			return fmt.Errorf("%s must be a valid RFC-compliant email address", field)
+		addr, err := mail.ParseAddress(address)
+		if err != nil || addr.Name != "" {
+			return validator.NewError(field, "email.rfc", ":attribute must be a valid RFC-compliant email address", nil)
+		}
+
+		if mode.checkStrict && !isStrictAddress(address) {
+			return validator.NewError(field, "email.strict", ":attribute must be a plain email address at a valid domain name", nil)
 		}
+		address = addr.Address
+	} else if !isBasicAddress(address) {
+		return validator.NewError(field, "email", ":attribute must be a valid email format (missing '@' or domain)", nil)
 	}
 
-	// 3. DNS MX record check on domain
+	// 4. RFC 5321 length limits
+	at := strings.LastIndex(address, "@")
+	if len(address) > maxEmailLength || at > maxEmailLocalLength {
+		return validator.NewError(field, "email.length", ":attribute must not be longer than :max characters, with at most :local before the '@'", validator.Args{"max": maxEmailLength, "local": maxEmailLocalLength})
+	}
+
+	local, domain := address[:at], strings.ToLower(address[at+1:])
+
+	// 5. Mixed-script local parts and domain labels
+	if mode.checkSpoof && isSpoofed(local, domain) {
+		return validator.NewError(field, "email.spoof", ":attribute mixes characters from different scripts", nil)
+	}
+
+	// 6. Disposable and blocked domains
+	if mode.noDisposable && r.isDisposable(domain) {
+		return validator.NewError(field, "email.disposable", ":attribute must not be a disposable email address", validator.Args{"domain": domain})
+	}
+
+	// 7. DNS check on the domain, in its ASCII form
 	if mode.checkDNS {
-		domain := strings.ToLower(strings.SplitN(addr.Address, "@", 2)[1])
+		asciiDomain, err := toASCII(domain)
+		if err == nil {
+			err = r.checkMailDomain(ctx, asciiDomain)
+		}
 
-		mxRecords, err := net.LookupMX(domain)
-		if err != nil || len(mxRecords) == 0 {
-			return fmt.Errorf("%s domain '%s' does not have valid MX records", field, domain)
+		switch {
+		case err == nil:
+		case errors.Is(err, errDNSTimeout):
+			return validator.NewError(field, "email.dns_unavailable", ":attribute domain ':domain' could not be checked in time", validator.Args{"domain": domain})
+		case errors.Is(err, errNullMX):
+			return validator.NewError(field, "email.null_mx", ":attribute domain ':domain' does not accept email", validator.Args{"domain": domain})
+		default:
+			return validator.NewError(field, "email.dns", ":attribute domain ':domain' does not have valid MX records", validator.Args{"domain": domain})
 		}
 	}
 
 	return nil
 }
 
-// parseEmailMode parses rule parameters and returns the enabled validation modes.
-// Defaults to basic-only validation if no parameters are specified.
-func parseEmailMode(params []string) emailValidationMode {
-	if len(params) == 0 {
-		return emailValidationMode{basicOnly: true}
+// isDisplayForm reports whether str is an address in angle brackets, optionally
+// preceded by a display name, as in "Rick <rick@astley.com>".
+func isDisplayForm(str string) bool {
+	return strings.HasSuffix(strings.TrimSpace(str), ">")
+}
+
+// isBasicAddress reports whether address passes the basic format check. Non-ASCII
+// letters, digits and marks are accepted in the local part, and the domain is checked
+// in its punycode form, so internationalized addresses pass if the mode allows them.
+func isBasicAddress(address string) bool {
+	if isASCII(address) {
+		return basicEmailRegex.MatchString(address)
 	}
 
+	at := strings.LastIndex(address, "@")
+	if at <= 0 {
+		return false
+	}
+
+	local := strings.Map(func(r rune) rune {
+		if r >= utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)) {
+			return 'a'
+		}
+		return r
+	}, address[:at])
+
+	domain, err := toASCII(address[at+1:])
+	if err != nil {
+		return false
+	}
+
+	return basicEmailRegex.MatchString(local+"@example.com") && idnDomainRegex.MatchString(domain)
+}
+
+// isStrictAddress reports whether an RFC-compliant address also passes the strict
+// checks: its local part is not quoted, and its domain is a host name rather than
+// an address literal, with at least two labels of letters, digits and hyphens
+// (in punycode form) and a top-level domain that is not numeric.
+func isStrictAddress(address string) bool {
+	at := strings.LastIndex(address, "@")
+	if at <= 0 || strings.Contains(address[:at], `"`) {
+		return false
+	}
+
+	domain, err := toASCII(address[at+1:])
+	if err != nil || len(domain) > maxDomainLength {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if len(label) > maxLabelLength || !hostnameLabelRegex.MatchString(label) {
+			return false
+		}
+	}
+
+	_, numeric := validator.ParseDecimal(labels[len(labels)-1])
+	return !numeric
+}
+
+// isDisposable reports whether domain is rejected by the "nodisposable" mode: it is in
+// the rule's Block or Disposable list, and not in its Allow list.
+func (r EmailRule) isDisposable(domain string) bool {
+	if r.Allow.Contains(domain) {
+		return false
+	}
+
+	disposable := r.Disposable
+	if disposable == nil {
+		disposable = DisposableDomains
+	}
+
+	return r.Block.Contains(domain) || disposable.Contains(domain)
+}
+
+// isSpoofed reports whether the local part or any label of the domain mixes scripts.
+func isSpoofed(local, domain string) bool {
+	if isMixedScript(local) {
+		return true
+	}
+
+	for _, label := range strings.Split(labelSeparators.Replace(domain), ".") {
+		if isMixedScript(label) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseEmailMode parses rule parameters and returns the enabled validation modes.
+// Defaults to basic-only validation if no parameters are specified.
+// Returns an error with the code "email.param" if a mode is unknown.
+func parseEmailMode(field string, params []string) (emailValidationMode, error) {
 	mode := emailValidationMode{}
-	flags := strings.Split(params[0], ",")
 
-	for _, flag := range flags {
-		switch strings.TrimSpace(strings.ToLower(flag)) {
+	for _, flag := range trimParams(params) {
+		switch strings.ToLower(flag) {
 		case "rfc":
 			mode.checkRFC = true
+		case "strict":
+			mode.checkRFC = true
+			mode.checkStrict = true
+		case "idn":
+			mode.allowIDN = true
+		case "spoof":
+			mode.checkSpoof = true
+		case "nodisposable":
+			mode.noDisposable = true
 		case "dns":
 			mode.checkDNS = true
+		case "display_name":
+			mode.allowDisplay = true
+		default:
+			return emailValidationMode{}, validator.NewError(field, "email.param", ":attribute: unknown email mode ':mode' (use rfc, strict, idn, spoof, nodisposable, dns or display_name)", validator.Args{"mode": flag})
 		}
 	}
 
-	return mode
+	return mode, nil
 }
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// Reasons a domain fails the email rule's "dns" mode.
+var (
+	// errNullMX reports a domain publishing a null MX record (RFC 7505),
+	// declaring that it accepts no email.
+	errNullMX = errors.New("domain accepts no email")
+
+	// errNoMailHost reports a domain with neither MX nor A/AAAA records.
+	errNoMailHost = errors.New("domain has no mail host")
+
+	// errDNSTimeout reports a lookup that timed out or was cancelled.
+	errDNSTimeout = errors.New("lookup timed out")
+)
+
+// checkMailDomain checks that domain, in its ASCII form, can receive email.
+// Following RFC 5321, the domain's MX records are used, or if it has none, its
+// A and AAAA records as an implicit MX. The lookups are bounded by ctx and the
+// rule's timeout.
+// Returns errNullMX, errNoMailHost or errDNSTimeout, or the resolver's error if
+// the MX lookup failed for another reason.
+func (r EmailRule) checkMailDomain(ctx context.Context, domain string) error {
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = DefaultDNSTimeout
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resolver := r.resolver()
+
+	records, err := resolver.LookupMX(lookupCtx, domain)
+	switch {
+	case lookupCtx.Err() != nil || isTimeout(err):
+		return errDNSTimeout
+	case err == nil && isNullMX(records):
+		return errNullMX
+	case err == nil && len(records) > 0:
+		return nil
+	case err != nil && !isNotFound(err):
+		return err
+	}
+
+	addrs, err := resolver.LookupIPAddr(lookupCtx, domain)
+	switch {
+	case lookupCtx.Err() != nil || isTimeout(err):
+		return errDNSTimeout
+	case err != nil || len(addrs) == 0:
+		return errNoMailHost
+	default:
+		return nil
+	}
+}
+
+// resolver returns the rule's resolver, or net.DefaultResolver if it has none.
+func (r EmailRule) resolver() Resolver {
+	if r.Resolver != nil {
+		return r.Resolver
+	}
+	return net.DefaultResolver
+}
+
+// isNullMX reports whether MX records declare that the domain accepts no email:
+// a record whose host is the root, "." (RFC 7505).
+func isNullMX(records []*net.MX) bool {
+	for _, mx := range records {
+		if mx.Host == "." || mx.Host == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isTimeout reports whether a DNS lookup failed because it ran out of time.
+func isTimeout(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &dnsErr) && dnsErr.IsTimeout)
+}
+
+// isNotFound reports whether a DNS lookup failed because the records do not exist.
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
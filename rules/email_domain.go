@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"net/mail"
+	"strings"
+	"sync"
+
+	"github.com/shivajichalise/validator"
+)
+
+// emailDomainParams are the parsed parameters of an email_domain rule.
+type emailDomainParams struct {
+	allow bool        // whether the rule is "in" rather than "not_in"
+	list  *DomainList // the listed domains
+}
+
+// emailDomainCache holds the emailDomainParams of each parameter list already parsed,
+// keyed by the parameters joined with NUL, so the domains of a rule are converted to
+// their ASCII form once rather than on every validation.
+var emailDomainCache sync.Map
+
+// EmailDomainRule validates the domain of an email address against a list of domains,
+// which also matches their subdomains (see DomainList).
+// Use "email_domain:in,domain,..." to accept only the listed domains, or
+// "email_domain:not_in,domain,..." to reject them, e.g. "email_domain:in,astley.com"
+// accepts "rick@astley.com" and "rick@music.astley.com".
+// The address itself is not checked; combine it with the email rule.
+type EmailDomainRule struct{}
+
+func init() {
+	validator.RegisterRule(EmailDomainRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "email_domain").
+func (r EmailDomainRule) Name() string {
+	return "email_domain"
+}
+
+// CheckParams checks that "in" or "not_in" is followed by at least one valid domain.
+func (r EmailDomainRule) CheckParams(field string, params ...string) error {
+	_, _, err := parseEmailDomainParams(field, params)
+	return err
+}
+
+// Validate checks whether the domain of the address is in the listed domains
+// ("email_domain:in,...") or not in them ("email_domain:not_in,...").
+// Returns an error if the parameters are malformed, the value is not a string
+// holding an '@', or the domain is not accepted.
+func (r EmailDomainRule) Validate(field string, value any, params ...string) error {
+	allow, list, err := parseEmailDomainParams(field, params)
+	if err != nil {
+		return err
+	}
+
+	str, ok := validator.Deref(value).(string)
+	if !ok {
+		return validator.NewError(field, "email_domain.type", ":attribute must be an email address", nil)
+	}
+
+	domain, ok := addressDomain(str)
+	if !ok {
+		return validator.NewError(field, "email_domain.type", ":attribute must be an email address", nil)
+	}
+
+	if list.Contains(domain) != allow {
+		return validator.NewError(field, "email_domain", ":attribute domain ':domain' is not allowed", validator.Args{"domain": domain})
+	}
+
+	return nil
+}
+
+// addressDomain returns the lowercase domain of an email address, which may have a
+// display name, as in "Rick <rick@astley.com>".
+// Returns false if the address has no '@' or nothing after it.
+func addressDomain(str string) (string, bool) {
+	address := strings.TrimSpace(str)
+	if isDisplayForm(address) {
+		addr, err := mail.ParseAddress(address)
+		if err != nil {
+			return "", false
+		}
+		address = addr.Address
+	}
+
+	at := strings.LastIndex(address, "@")
+	if at < 0 || at == len(address)-1 {
+		return "", false
+	}
+
+	return strings.ToLower(address[at+1:]), true
+}
+
+// parseEmailDomainParams parses "in" or "not_in" and the domains that follow it,
+// reusing the result of an earlier call with the same parameters.
+// Returns true for "in", and an error with the code "email_domain.param" if the
+// parameters are malformed.
+func parseEmailDomainParams(field string, params []string) (bool, *DomainList, error) {
+	key := strings.Join(params, "\x00")
+	if cached, ok := emailDomainCache.Load(key); ok {
+		p := cached.(emailDomainParams)
+		return p.allow, p.list, nil
+	}
+
+	p, err := newEmailDomainParams(field, params)
+	if err != nil {
+		return false, nil, err
+	}
+
+	emailDomainCache.Store(key, p)
+	return p.allow, p.list, nil
+}
+
+// newEmailDomainParams parses the parameters of an email_domain rule without the cache.
+func newEmailDomainParams(field string, params []string) (emailDomainParams, error) {
+	params = trimParams(params)
+	if len(params) < 2 {
+		return emailDomainParams{}, validator.NewError(field, "email_domain.param", ":attribute: email_domain rule requires 'in' or 'not_in' followed by domains (e.g., 'email_domain:in,example.com')", nil)
+	}
+
+	var allow bool
+	switch strings.ToLower(params[0]) {
+	case "in":
+		allow = true
+	case "not_in":
+		allow = false
+	default:
+		return emailDomainParams{}, validator.NewError(field, "email_domain.param", ":attribute: email_domain rule requires 'in' or 'not_in' followed by domains (e.g., 'email_domain:in,example.com')", nil)
+	}
+
+	for _, domain := range params[1:] {
+		if _, ok := canonicalDomain(domain); !ok {
+			return emailDomainParams{}, validator.NewError(field, "email_domain.param", ":attribute: email_domain rule has an invalid domain ':domain'", validator.Args{"domain": domain})
+		}
+	}
+
+	return emailDomainParams{allow: allow, list: NewDomainList(params[1:]...)}, nil
+}
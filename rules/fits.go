@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/shivajichalise/validator"
+)
+
+// fitsKinds maps the type names accepted by the fits rule to their kinds.
+var fitsKinds = map[string]reflect.Kind{
+	"int":     reflect.Int,
+	"int8":    reflect.Int8,
+	"int16":   reflect.Int16,
+	"int32":   reflect.Int32,
+	"int64":   reflect.Int64,
+	"uint":    reflect.Uint,
+	"uint8":   reflect.Uint8,
+	"uint16":  reflect.Uint16,
+	"uint32":  reflect.Uint32,
+	"uint64":  reflect.Uint64,
+	"float32": reflect.Float32,
+	"float64": reflect.Float64,
+}
+
+// FitsRule validates that a numeric value can be stored in a given Go numeric type
+// without overflow or loss, e.g. that a float64 or json.Number decoded from JSON
+// fits the int16 field it will be copied into.
+// Use "fits:type" in rule expressions (e.g., "fits:int16" or "fits:uint8").
+type FitsRule struct{}
+
+func init() {
+	validator.RegisterRule(FitsRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "fits").
+func (r FitsRule) Name() string {
+	return "fits"
+}
+
+// CheckParams checks that a single Go numeric type name is given (e.g., "fits:int16").
+func (r FitsRule) CheckParams(field string, params ...string) error {
+	if len(params) != 1 {
+		return validator.NewError(field, "fits.param", ":attribute: fits rule requires a single type name (e.g., 'fits:int16')", nil)
+	}
+
+	if _, ok := fitsKinds[strings.TrimSpace(params[0])]; !ok {
+		return validator.NewError(field, "fits.param", ":attribute: fits rule does not support type ':type' (use int, int8 to int64, uint, uint8 to uint64, float32 or float64)", validator.Args{"type": params[0]})
+	}
+
+	return nil
+}
+
+// Validate checks whether the value can be stored in the given type (e.g., "fits:int16").
+// Integer types require a whole number within their range; float32 and float64
+// require a value within their range (see validator.Fits).
+// Returns an error if the parameter is malformed, the value is not numeric, or
+// the value does not fit.
+func (r FitsRule) Validate(field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	typ := strings.TrimSpace(params[0])
+
+	fits, err := validator.Fits(value, fitsKinds[typ])
+	if err != nil {
+		return validator.NewError(field, "fits.type", ":attribute must be numeric to use fits", nil)
+	}
+
+	if !fits {
+		return validator.NewError(field, "fits", ":attribute must fit in :type", validator.Args{"type": typ})
+	}
+
+	return nil
+}
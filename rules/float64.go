@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"fmt"
 	"reflect"
 
 	"github.com/shivajichalise/validator"
@@ -21,10 +20,11 @@ func (r Float64Rule) Name() string {
 }
 
 // Validate checks whether the given value is of type float64.
-// Returns an error if the value is not exactly a float64.
+// Pointers and driver.Valuer types are dereferenced first (see validator.Deref).
+// Returns an error if the value is nil or not exactly a float64.
 func (r Float64Rule) Validate(field string, value any, _ ...string) error {
-	if reflect.TypeOf(value).Kind() == reflect.Float64 {
+	if reflect.ValueOf(validator.Deref(value)).Kind() == reflect.Float64 {
 		return nil
 	}
-	return fmt.Errorf("%s must be a float64 value", field)
+	return validator.NewError(field, "float64", ":attribute must be a float64 value", nil)
 }
@@ -1,14 +1,14 @@
 package rules
 
 import (
-	"fmt"
-	"reflect"
-	"strconv"
+	"math/big"
+	"strings"
 
 	"github.com/shivajichalise/validator"
 )
 
-// GtRule validates that a numeric field is greater than a specified threshold.
+// GtRule validates that a numeric field is greater than a specified threshold
+// or than the value of another field.
 type GtRule struct{}
 
 func init() {
@@ -20,32 +20,59 @@ func (r GtRule) Name() string {
 	return "gt"
 }
 
-// Validate checks whether the given numeric value is strictly greater than the specified threshold.
-// The threshold must be passed as a parameter (e.g., "gt:10").
+// CheckParams checks that a single comparison value is given (e.g., "gt:10" or "gt:other_field").
+// Whether a field reference exists can only be checked against a payload.
+func (r GtRule) CheckParams(field string, params ...string) error {
+	if len(params) != 1 || strings.TrimSpace(params[0]) == "" {
+		return validator.NewError(field, "gt.param", ":attribute: gt rule requires a single comparison value", nil)
+	}
+
+	return nil
+}
+
+// Validate checks the value against a numeric threshold (e.g., "gt:10").
+// Field references cannot be resolved without a payload; use ValidateData for those.
+func (r GtRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the given value is strictly greater than the specified threshold.
+// The threshold must be passed as a parameter, either a number (e.g., "gt:10") or the
+// name of another field (e.g., "gt:min_price"). Fields holding dates,
+// as time.Time values or RFC 3339 or "2006-01-02" strings, can be compared with each
+// other. Numeric strings such as "17" are compared as numbers (see validator.NormalizeNumber).
 // Returns an error if the value is not numeric, if the parameter is missing,
 // or if the value is not greater than the threshold.
-// If an integer is being compared, the threshold must be a whole number.
-func (r GtRule) Validate(field string, value any, params ...string) error {
-	if len(params) == 0 {
-		return fmt.Errorf("%s: gt rule requires a comparison value", field)
+// If an integer is compared with a numeric literal, the threshold must be a whole number.
+func (r GtRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
 	}
 
-	gtValue, err := strconv.ParseFloat(params[0], 64)
-	if err != nil {
-		return fmt.Errorf("%s: gt parameter must be a valid number", field)
+	threshold, ok := resolveOperand(data, field, params[0])
+	if !ok {
+		return validator.NewError(field, "gt.param", ":attribute: gt parameter must be a valid number or field name", nil)
 	}
 
-	num, err := validator.ToFloat64(value)
-	if err != nil {
-		return fmt.Errorf("%s must be numeric to use gt (apply 'numeric', 'int', or 'float64' rule first)", field)
+	value = validator.NormalizeNumber(value)
+
+	if threshold.literal && validator.NumberKindOf(value) == validator.IntegerKind && !threshold.value.(*big.Rat).IsInt() {
+		return validator.NewError(field, "gt.whole_number", "gt value :value must be a whole number when :attribute is an integer", validator.Args{"value": threshold.label})
 	}
 
-	if reflect.TypeOf(value).Kind() == reflect.Int && !validator.IsWholeNumber(gtValue) {
-		return fmt.Errorf("gt value %.2f must be a whole number when %s is an integer", gtValue, field)
+	cmp, err := compareValues(value, threshold.value)
+	if err != nil {
+		if threshold.literal {
+			return validator.NewError(field, "gt.type", ":attribute must be numeric to use gt (apply 'numeric', 'int', or 'float64' rule first)", nil)
+		}
+		return validator.NewError(field, "gt.incomparable", ":attribute and :other must both be numeric or dates to use gt", validator.Args{"other": threshold.label})
 	}
 
-	if num <= gtValue {
-		return fmt.Errorf("%s must be greater than %v", field, gtValue)
+	if cmp <= 0 {
+		if threshold.literal {
+			return validator.NewError(field, "gt", ":attribute must be greater than :value", validator.Args{"value": threshold.label})
+		}
+		return validator.NewError(field, "gt.field", ":attribute must be greater than :other", validator.Args{"other": threshold.label, "value": threshold.value})
 	}
 
 	return nil
@@ -1,14 +1,13 @@
 package rules
 
 import (
-	"fmt"
-	"reflect"
-
 	"github.com/shivajichalise/validator"
 )
 
 // IntRule ensures that a field's value is an integer type.
-// Supported types include int, int8, int16, int32, and int64.
+// Supported types include every signed and unsigned integer type, *big.Int,
+// and json.Number values written without a fraction or exponent (see validator.NumberKindOf),
+// as well as integer strings such as "42". Use "int:strict" to reject strings.
 type IntRule struct{}
 
 func init() {
@@ -20,15 +19,29 @@ func (r IntRule) Name() string {
 	return "int"
 }
 
+// CheckParams checks that the only parameter, if any, is the "strict" mode.
+func (r IntRule) CheckParams(field string, params ...string) error {
+	_, err := parseStrictMode(field, r.Name(), params)
+	return err
+}
+
 // Validate checks whether the value is of an integer type.
-// Returns an error if the value is not a supported integer kind.
-func (r IntRule) Validate(field string, value any, _ ...string) error {
-	kind := reflect.TypeOf(value).Kind()
-
-	switch kind {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return nil
-	default:
-		return fmt.Errorf("%s must be an integer", field)
+// Pointers and driver.Valuer types are dereferenced first (see validator.Deref).
+// By default, strings holding a decimal integer (e.g., "17") are integers too;
+// with "int:strict" they are not.
+// Returns an error if the value is nil or not an integer.
+func (r IntRule) Validate(field string, value any, params ...string) error {
+	strict, err := parseStrictMode(field, r.Name(), params)
+	if err != nil {
+		return err
+	}
+
+	if !strict {
+		value = validator.NormalizeNumber(value)
+	}
+
+	if validator.NumberKindOf(value) != validator.IntegerKind {
+		return validator.NewError(field, "int", ":attribute must be an integer", nil)
 	}
+	return nil
 }
@@ -0,0 +1,308 @@
+package rules
+
+import (
+	"io/fs"
+	"math/big"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shivajichalise/validator"
+)
+
+// lengthMode selects how the length of a string is measured by the size rules. It is
+// given as the optional second parameter of min, max and size (e.g., "max:20,bytes"),
+// and defaults to lengthRunes.
+type lengthMode string
+
+// Length modes, given as the optional second parameter of a size rule (e.g., "max:20,bytes").
+const (
+	// lengthRunes counts Unicode code points. It is the default.
+	lengthRunes lengthMode = "runes"
+
+	// lengthBytes counts UTF-8 encoded bytes, for enforcing storage limits.
+	lengthBytes lengthMode = "bytes"
+
+	// lengthGraphemes counts user-perceived characters (grapheme clusters), so an
+	// emoji or a letter with combining accents counts once, for enforcing display length.
+	lengthGraphemes lengthMode = "graphemes"
+)
+
+// zeroWidthJoiner joins emoji into a single user-perceived character (e.g., 👩‍💻).
+const zeroWidthJoiner = '\u200d'
+
+// sizeKind tells how the size rules (min, max and size) measured a value. It is
+// appended to the rule name to form the error code, e.g. "min.array".
+type sizeKind string
+
+// Ways of measuring a value for the size rules.
+const (
+	// sizeCharacters is the length of a string in runes or graphemes.
+	sizeCharacters sizeKind = ""
+
+	// sizeBytes is the length of a string in bytes.
+	sizeBytes sizeKind = ".bytes"
+
+	// sizeNumeric is the value of a number.
+	sizeNumeric sizeKind = ".numeric"
+
+	// sizeArray is the number of elements of a slice, array or map.
+	sizeArray sizeKind = ".array"
+
+	// sizeFile is the size of a file in bytes.
+	sizeFile sizeKind = ".file"
+)
+
+// sizeParams holds the parsed parameters of a size rule.
+type sizeParams struct {
+	bound *big.Rat   // the bound the size is compared with, exactly
+	label string     // the bound as written in the rule expression, for messages
+	mode  lengthMode // how strings are measured
+}
+
+// parseSizeParams parses the parameters of a size rule: a numeric bound and an
+// optional length mode for strings (e.g., "min:5", "max:9.99" or "min:5,graphemes").
+// Returns an error with the code "<rule>.param" if the parameters are malformed.
+func parseSizeParams(field, rule string, params []string) (sizeParams, error) {
+	if len(params) == 0 || len(params) > 2 {
+		return sizeParams{}, validator.NewError(field, rule+".param", ":attribute: :rule rule requires a length parameter and an optional mode", validator.Args{"rule": rule})
+	}
+
+	label := strings.TrimSpace(params[0])
+	bound, ok := validator.ParseDecimal(label)
+	if !ok {
+		return sizeParams{}, validator.NewError(field, rule+".param", ":attribute: :rule value must be a valid number", validator.Args{"rule": rule})
+	}
+
+	p := sizeParams{bound: bound, label: label, mode: lengthRunes}
+	if len(params) == 1 {
+		return p, nil
+	}
+
+	p.mode = lengthMode(strings.ToLower(strings.TrimSpace(params[1])))
+	switch p.mode {
+	case lengthRunes, lengthBytes, lengthGraphemes:
+		return p, nil
+	default:
+		return sizeParams{}, validator.NewError(field, rule+".param", ":attribute: unknown :rule mode ':mode' (use runes, bytes or graphemes)", validator.Args{"rule": rule, "mode": params[1]})
+	}
+}
+
+// measure returns the size of a value as compared by the size rules: the length of
+// a string (measured in the given mode), the value of a number, the number of elements
+// of a slice, array or map, or the size in bytes of a file (see fileSize). Numbers are
+// returned unchanged so they can be compared exactly (see validator.CompareNumbers).
+// Returns false if the value has no size.
+func measure(value any, mode lengthMode) (any, sizeKind, bool) {
+	if size, ok := fileSize(value); ok {
+		return size, sizeFile, true
+	}
+
+	value = validator.Deref(value)
+	if value == nil {
+		return nil, "", false
+	}
+
+	if validator.NumberKindOf(value) != validator.NonNumeric {
+		return value, sizeNumeric, true
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		if mode == lengthBytes {
+			return len(rv.String()), sizeBytes, true
+		}
+		return stringLength(rv.String(), mode), sizeCharacters, true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), sizeArray, true
+	default:
+		return nil, "", false
+	}
+}
+
+// fileSize returns the size in bytes of an uploaded file (a multipart.FileHeader) or
+// an fs.FileInfo. Files read from nested data or struct fields are passed by value
+// rather than by pointer, so both forms are recognized.
+// Returns false if value is not a file or is a nil pointer.
+func fileSize(value any) (int64, bool) {
+	switch file := value.(type) {
+	case *multipart.FileHeader:
+		if file != nil {
+			return file.Size, true
+		}
+		return 0, false
+	case multipart.FileHeader:
+		return file.Size, true
+	case fs.FileInfo:
+		if !validator.IsNil(file) {
+			return file.Size(), true
+		}
+		return 0, false
+	}
+
+	// A struct value whose pointer implements fs.FileInfo, such as a dereferenced *os.fileStat.
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+	if info, ok := ptr.Interface().(fs.FileInfo); ok {
+		return info.Size(), true
+	}
+
+	return 0, false
+}
+
+// validateSize runs a size rule such as "min:5": it measures value (see measure) and
+// passes if accept returns true for the comparison of the size with the bound, which
+// is -1, 0 or +1 as the size is less than, equal to or greater than the bound.
+// messages holds the message template for each way of measuring the value.
+func validateSize(field, rule string, value any, params []string, accept func(int) bool, messages map[sizeKind]string) error {
+	p, err := parseSizeParams(field, rule, params)
+	if err != nil {
+		return err
+	}
+
+	size, kind, ok := measure(value, p.mode)
+	if !ok {
+		return validator.NewError(field, rule+".type", ":attribute must be a string, number, collection or file to use :rule", validator.Args{"rule": rule})
+	}
+
+	c, err := validator.CompareNumbers(size, p.bound)
+	if err != nil {
+		return validator.NewError(field, rule+".type", ":attribute must be a string, number, collection or file to use :rule", validator.Args{"rule": rule})
+	}
+
+	if accept(c) {
+		return nil
+	}
+
+	return validator.NewError(field, rule+string(kind), messages[kind], validator.Args{rule: p.label})
+}
+
+// stringLength returns the length of s measured in the given mode.
+func stringLength(s string, mode lengthMode) int {
+	switch mode {
+	case lengthBytes:
+		return len(s)
+	case lengthGraphemes:
+		return graphemeCount(s)
+	default:
+		return utf8.RuneCountInString(s)
+	}
+}
+
+// graphemeCount counts the user-perceived characters of s. It follows the main
+// rules of Unicode extended grapheme clusters (UAX #29): combining marks, variation
+// selectors, emoji modifiers and tags extend the preceding character, a zero-width
+// joiner fuses two emoji, a pair of regional indicators forms one flag, Hangul jamo
+// combine into syllables, and CR LF counts as one.
+func graphemeCount(s string) int {
+	count := 0
+	regional := 0 // consecutive regional indicators ending at prev
+	var prev rune
+
+	for i, r := range s {
+		if i == 0 || isGraphemeBoundary(prev, r, regional) {
+			count++
+		}
+
+		if isRegionalIndicator(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = r
+	}
+
+	return count
+}
+
+// isGraphemeBoundary reports whether a new grapheme cluster starts at r after prev.
+// regional is the number of consecutive regional indicators ending at prev.
+func isGraphemeBoundary(prev, r rune, regional int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case unicode.IsControl(prev) || unicode.IsControl(r):
+		return true
+	case hangulJoins(prev, r):
+		return false
+	case isGraphemeExtend(r):
+		return false
+	case prev == zeroWidthJoiner && isPictographic(r):
+		return false
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return regional%2 == 0
+	default:
+		return true
+	}
+}
+
+// isGraphemeExtend reports whether r extends the preceding grapheme cluster.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // emoji skin tone modifiers
+		(r >= 0xE0020 && r <= 0xE007F) // tags, used by subdivision flags
+}
+
+// isPictographic reports whether r is an emoji or other pictographic symbol.
+func isPictographic(r rune) bool {
+	return unicode.Is(unicode.So, r) || (r >= 0x1F000 && r <= 0x1FAFF)
+}
+
+// isRegionalIndicator reports whether r is a regional indicator, pairs of which form flags.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// Hangul syllable types, used to combine conjoining jamo into syllables.
+const (
+	hangulNone = iota
+	hangulL    // leading consonant
+	hangulV    // vowel
+	hangulT    // trailing consonant
+	hangulLV   // precomposed syllable without a trailing consonant
+	hangulLVT  // precomposed syllable with a trailing consonant
+)
+
+// hangulType returns the Hangul syllable type of r.
+func hangulType(r rune) int {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return hangulL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return hangulV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	default:
+		return hangulNone
+	}
+}
+
+// hangulJoins reports whether the Hangul jamo or syllables prev and r form one syllable.
+func hangulJoins(prev, r rune) bool {
+	next := hangulType(r)
+
+	switch hangulType(prev) {
+	case hangulL:
+		return next == hangulL || next == hangulV || next == hangulLV || next == hangulLVT
+	case hangulLV, hangulV:
+		return next == hangulV || next == hangulT
+	case hangulLVT, hangulT:
+		return next == hangulT
+	default:
+		return false
+	}
+}
@@ -1,15 +1,14 @@
 package rules
 
 import (
-	"fmt"
-	"reflect"
-	"strconv"
+	"math/big"
+	"strings"
 
 	"github.com/shivajichalise/validator"
 )
 
-// LtRule validates that a numeric field is less than a specified threshold.
-// It supports int, float32, and float64 types.
+// LtRule validates that a numeric field is less than a specified threshold
+// or than the value of another field.
 type LtRule struct{}
 
 func init() {
@@ -21,32 +20,59 @@ func (r LtRule) Name() string {
 	return "lt"
 }
 
-// Validate checks whether the given numeric value is strictly less than the provided threshold.
-// The threshold must be passed as a parameter (e.g., "lt:100").
-// Returns an error if the value is not numeric, the parameter is missing,
-// or if the comparison fails.
-// If the value is an integer, the threshold must be a whole number.
+// CheckParams checks that a single comparison value is given (e.g., "lt:10" or "lt:other_field").
+// Whether a field reference exists can only be checked against a payload.
+func (r LtRule) CheckParams(field string, params ...string) error {
+	if len(params) != 1 || strings.TrimSpace(params[0]) == "" {
+		return validator.NewError(field, "lt.param", ":attribute: lt rule requires a single comparison value", nil)
+	}
+
+	return nil
+}
+
+// Validate checks the value against a numeric threshold (e.g., "lt:100").
+// Field references cannot be resolved without a payload; use ValidateData for those.
 func (r LtRule) Validate(field string, value any, params ...string) error {
-	if len(params) == 0 {
-		return fmt.Errorf("%s: lt rule requires a comparison value", field)
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the given value is strictly less than the specified threshold.
+// The threshold must be passed as a parameter, either a number (e.g., "lt:100") or the
+// name of another field (e.g., "lt:max_price"). Fields holding dates,
+// as time.Time values or RFC 3339 or "2006-01-02" strings, can be compared with each
+// other. Numeric strings such as "17" are compared as numbers (see validator.NormalizeNumber).
+// Returns an error if the value is not numeric, if the parameter is missing,
+// or if the value is not less than the threshold.
+// If an integer is compared with a numeric literal, the threshold must be a whole number.
+func (r LtRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
 	}
 
-	ltValue, err := strconv.ParseFloat(params[0], 64)
-	if err != nil {
-		return fmt.Errorf("%s: lt parameter must be a valid number", field)
+	threshold, ok := resolveOperand(data, field, params[0])
+	if !ok {
+		return validator.NewError(field, "lt.param", ":attribute: lt parameter must be a valid number or field name", nil)
 	}
 
-	num, err := validator.ToFloat64(value)
-	if err != nil {
-		return fmt.Errorf("%s must be numeric to use lt (apply 'numeric', 'int', or 'float64' rule first)", field)
+	value = validator.NormalizeNumber(value)
+
+	if threshold.literal && validator.NumberKindOf(value) == validator.IntegerKind && !threshold.value.(*big.Rat).IsInt() {
+		return validator.NewError(field, "lt.whole_number", "lt value :value must be a whole number when :attribute is an integer", validator.Args{"value": threshold.label})
 	}
 
-	if reflect.TypeOf(value).Kind() == reflect.Int && !validator.IsWholeNumber(ltValue) {
-		return fmt.Errorf("lt value %.2f must be a whole number when %s is an integer", ltValue, field)
+	cmp, err := compareValues(value, threshold.value)
+	if err != nil {
+		if threshold.literal {
+			return validator.NewError(field, "lt.type", ":attribute must be numeric to use lt (apply 'numeric', 'int', or 'float64' rule first)", nil)
+		}
+		return validator.NewError(field, "lt.incomparable", ":attribute and :other must both be numeric or dates to use lt", validator.Args{"other": threshold.label})
 	}
 
-	if num >= ltValue {
-		return fmt.Errorf("%s must be less than %v", field, ltValue)
+	if cmp >= 0 {
+		if threshold.literal {
+			return validator.NewError(field, "lt", ":attribute must be less than :value", validator.Args{"value": threshold.label})
+		}
+		return validator.NewError(field, "lt.field", ":attribute must be less than :other", validator.Args{"other": threshold.label, "value": threshold.value})
 	}
 
 	return nil
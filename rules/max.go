@@ -1,17 +1,24 @@
 package rules
 
 import (
-	"fmt"
-	"strconv"
-
 	"github.com/shivajichalise/validator"
 )
 
-// MaxRule validates that the length of a string does not exceed a specified maximum.
-// It only applies to string values.
-// Use "max:n" in your rule expression, where n is the maximum allowed length.
+// MaxRule validates that the size of a value does not exceed a specified maximum:
+// the length of a string, the value of a number, the number of elements of a
+// slice, array or map, or the size in bytes of a file. The bound is inclusive.
+// Use "max:n" in your rule expression (e.g., "max:20").
 type MaxRule struct{}
 
+// maxMessages holds the message of a failed "max" rule for each way of measuring the value.
+var maxMessages = map[sizeKind]string{
+	sizeCharacters: ":attribute must be at most :max characters",
+	sizeBytes:      ":attribute must be at most :max bytes",
+	sizeNumeric:    ":attribute must not be greater than :max",
+	sizeArray:      ":attribute must not have more than :max items",
+	sizeFile:       ":attribute must be at most :max bytes",
+}
+
 func init() {
 	validator.RegisterRule(MaxRule{})
 }
@@ -21,27 +28,18 @@ func (r MaxRule) Name() string {
 	return "max"
 }
 
-// Validate checks whether the length of a string value is less than or equal to the given maximum.
-// The maximum length must be provided as a parameter (e.g., "max:10").
-// Returns an error if the value is not a string, the parameter is missing, or the string exceeds the maximum length.
-func (r MaxRule) Validate(field string, value any, params ...string) error {
-	if len(params) == 0 {
-		return fmt.Errorf("%s: max rule requires a length parameter", field)
-	}
-
-	maxLen, err := strconv.Atoi(params[0])
-	if err != nil {
-		return fmt.Errorf("%s: max value must be a valid number", field)
-	}
-
-	str, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("%s must be a string to use max", field)
-	}
-
-	if len(str) > maxLen {
-		return fmt.Errorf("%s must be at most %d characters", field, maxLen)
-	}
+// CheckParams checks that the maximum is given as a number, optionally followed
+// by a length mode for strings (e.g., "max:20" or "max:20,bytes").
+func (r MaxRule) CheckParams(field string, params ...string) error {
+	_, err := parseSizeParams(field, r.Name(), params)
+	return err
+}
 
-	return nil
+// Validate checks whether the size of the value is less than or equal to the given maximum (e.g., "max:20").
+// Values are measured as described on measure; see lengthMode for string length modes.
+// Returns an error if the value has no size, the parameters are malformed, or the size exceeds the maximum.
+func (r MaxRule) Validate(field string, value any, params ...string) error {
+	return validateSize(field, r.Name(), value, params, func(cmp int) bool {
+		return cmp <= 0
+	}, maxMessages)
 }
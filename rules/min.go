@@ -1,17 +1,24 @@
 package rules
 
 import (
-	"fmt"
-	"strconv"
-
 	"github.com/shivajichalise/validator"
 )
 
-// MinRule validates that the length of a string is at least a specified minimum.
-// This rule applies only to string values.
-// Use "min:n" in rule expressions, where n is the required minimum number of characters.
+// MinRule validates that the size of a value is at least a specified minimum:
+// the length of a string, the value of a number, the number of elements of a
+// slice, array or map, or the size in bytes of a file. The bound is inclusive.
+// Use "min:n" in rule expressions (e.g., "min:5").
 type MinRule struct{}
 
+// minMessages holds the message of a failed "min" rule for each way of measuring the value.
+var minMessages = map[sizeKind]string{
+	sizeCharacters: ":attribute must be at least :min characters",
+	sizeBytes:      ":attribute must be at least :min bytes",
+	sizeNumeric:    ":attribute must be at least :min",
+	sizeArray:      ":attribute must have at least :min items",
+	sizeFile:       ":attribute must be at least :min bytes",
+}
+
 func init() {
 	validator.RegisterRule(MinRule{})
 }
@@ -21,27 +28,18 @@ func (r MinRule) Name() string {
 	return "min"
 }
 
-// Validate checks whether the length of a string value is greater than or equal to the specified minimum.
-// The minimum length must be provided as a parameter (e.g., "min:5").
-// Returns an error if the value is not a string, the parameter is missing, or the string is too short.
-func (r MinRule) Validate(field string, value any, params ...string) error {
-	if len(params) == 0 {
-		return fmt.Errorf("%s: min rule requires a length parameter", field)
-	}
-
-	minLen, err := strconv.Atoi(params[0])
-	if err != nil {
-		return fmt.Errorf("%s: min value must be a valid number", field)
-	}
-
-	str, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("%s must be a string to use min", field)
-	}
-
-	if len(str) < minLen {
-		return fmt.Errorf("%s must be at least %d characters", field, minLen)
-	}
+// CheckParams checks that the minimum is given as a number, optionally followed
+// by a length mode for strings (e.g., "min:5" or "min:5,bytes").
+func (r MinRule) CheckParams(field string, params ...string) error {
+	_, err := parseSizeParams(field, r.Name(), params)
+	return err
+}
 
-	return nil
+// Validate checks whether the size of the value is greater than or equal to the given minimum (e.g., "min:5").
+// Values are measured as described on measure; see lengthMode for string length modes.
+// Returns an error if the value has no size, the parameters are malformed, or the size is below the minimum.
+func (r MinRule) Validate(field string, value any, params ...string) error {
+	return validateSize(field, r.Name(), value, params, func(cmp int) bool {
+		return cmp >= 0
+	}, minMessages)
 }
@@ -1,13 +1,16 @@
 package rules
 
 import (
-	"fmt"
+	"strings"
 
 	"github.com/shivajichalise/validator"
 )
 
 // NumericRule validates that a value is numeric.
-// It accepts all standard Go numeric types: int, int8, int16, int32, int64, float32, and float64.
+// It accepts every signed and unsigned integer type, float32 and float64, json.Number,
+// *big.Int, *big.Float and *big.Rat, as well as numeric strings such as "42" or "-0.5",
+// which is how form and query-string input arrives. Use "numeric:strict" to accept
+// Go numeric types only.
 type NumericRule struct{}
 
 func init() {
@@ -19,13 +22,46 @@ func (r NumericRule) Name() string {
 	return "numeric"
 }
 
+// CheckParams checks that the only parameter, if any, is the "strict" mode.
+func (r NumericRule) CheckParams(field string, params ...string) error {
+	_, err := parseStrictMode(field, r.Name(), params)
+	return err
+}
+
 // Validate checks whether the given value is a supported numeric type.
+// By default, strings holding a decimal integer or decimal number (e.g., "17" or "9.99",
+// see validator.NormalizeNumber) are numeric too; "NaN", "Inf" and hexadecimal strings
+// are not. With "numeric:strict" only Go numeric types are accepted.
 // Returns an error if the value is not numeric.
-// Internally uses validator.ToFloat64 to normalize numeric types.
-func (r NumericRule) Validate(field string, value any, _ ...string) error {
-	_, err := validator.ToFloat64(value)
+// Internally uses validator.NumberKindOf to classify numeric types.
+func (r NumericRule) Validate(field string, value any, params ...string) error {
+	strict, err := parseStrictMode(field, r.Name(), params)
 	if err != nil {
-		return fmt.Errorf("%s must be a numeric value", field)
+		return err
+	}
+
+	if !strict {
+		value = validator.NormalizeNumber(value)
+	}
+
+	if validator.NumberKindOf(value) == validator.NonNumeric {
+		return validator.NewError(field, "numeric", ":attribute must be a numeric value", nil)
 	}
 	return nil
 }
+
+// parseStrictMode parses the optional mode parameter of the numeric and int rules.
+// Returns true for "strict", false if no mode is given, and an error with the
+// code "<rule>.param" for anything else.
+func parseStrictMode(field, rule string, params []string) (bool, error) {
+	params = trimParams(params)
+
+	switch {
+	case len(params) == 0:
+		return false, nil
+	case len(params) == 1 && strings.EqualFold(params[0], "strict"):
+		return true, nil
+	default:
+		return false, validator.NewError(field, rule+".param", ":attribute: :rule rule only accepts the 'strict' mode", validator.Args{"rule": rule})
+	}
+}
@@ -0,0 +1,170 @@
+package rules
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters from RFC 3492, section 5.
+const (
+	punycodeBase        = 36
+	punycodeTMin        = 1
+	punycodeTMax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+
+	// punycodeMaxDelta bounds the delta, which must fit in 32 bits.
+	punycodeMaxDelta = 1<<31 - 1
+)
+
+// acePrefix marks a domain label encoded with punycode (RFC 5890).
+const acePrefix = "xn--"
+
+// errPunycodeOverflow is returned for labels too long to encode.
+var errPunycodeOverflow = errors.New("punycode: label is too long")
+
+// labelSeparators maps the full stops that IDNA treats as label separators to '.'.
+var labelSeparators = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+// toASCII converts an internationalized domain name to its ASCII form, as used in DNS:
+// each label holding non-ASCII characters is lowercased and encoded with punycode
+// behind the "xn--" prefix, so "Bücher.de" becomes "xn--bcher-kva.de". ASCII labels
+// are returned unchanged. Unlike full IDNA processing (RFC 5891), characters are not
+// normalized beyond lowercasing.
+// Returns an error if a label cannot be encoded.
+func toASCII(domain string) (string, error) {
+	labels := strings.Split(labelSeparators.Replace(domain), ".")
+
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+
+		encoded, err := punycodeEncode(strings.ToLower(label))
+		if err != nil {
+			return "", err
+		}
+		labels[i] = acePrefix + encoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// isASCII reports whether s holds ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// punycodeEncode encodes a label with the punycode algorithm of RFC 3492, without the
+// "xn--" prefix, so "bücher" becomes "bcher-kva".
+func punycodeEncode(label string) (string, error) {
+	input := []rune(label)
+
+	var out strings.Builder
+	for _, r := range input {
+		if r < utf8.RuneSelf {
+			out.WriteRune(r)
+		}
+	}
+
+	basic := out.Len()
+	handled := basic
+	if basic > 0 {
+		out.WriteByte('-')
+	}
+
+	n, delta, bias := rune(punycodeInitialN), 0, punycodeInitialBias
+	for handled < len(input) {
+		m := rune(utf8.MaxRune + 1)
+		for _, r := range input {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+
+		if int(m-n) > (punycodeMaxDelta-delta)/(handled+1) {
+			return "", errPunycodeOverflow
+		}
+		delta += int(m-n) * (handled + 1)
+		n = m
+
+		for _, r := range input {
+			if r < n {
+				delta++
+				if delta == punycodeMaxDelta {
+					return "", errPunycodeOverflow
+				}
+			}
+
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := punycodeThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out.WriteByte(punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			out.WriteByte(punycodeDigit(q))
+
+			bias = punycodeAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+
+		delta++
+		n++
+	}
+
+	return out.String(), nil
+}
+
+// punycodeThreshold returns the threshold t for the digit at position k.
+func punycodeThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punycodeTMin
+	case k >= bias+punycodeTMax:
+		return punycodeTMax
+	default:
+		return k - bias
+	}
+}
+
+// punycodeDigit returns the character encoding the digit d (0 to 35).
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// punycodeAdapt returns the bias after encoding a character (RFC 3492, section 6.1).
+func punycodeAdapt(delta, points int, first bool) int {
+	if first {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+
+	k := 0
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/shivajichalise/validator"
+)
+
+// RequiredUnlessRule requires a field unless another field equals one of the given values.
+// Use "required_unless:other,value1,value2" in rule expressions.
+type RequiredUnlessRule struct {
+	conditionalRule
+}
+
+func init() {
+	validator.RegisterRule(RequiredUnlessRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "required_unless").
+func (r RequiredUnlessRule) Name() string {
+	return "required_unless"
+}
+
+// CheckParams checks that the other field and at least one value are given (e.g., "required_unless:account_type,personal").
+func (r RequiredUnlessRule) CheckParams(field string, params ...string) error {
+	if len(trimParams(params)) < 2 {
+		return validator.NewError(field, "required_unless.param", ":attribute: required_unless rule requires a field and at least one value", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the field is always required.
+// Use ValidateData to evaluate the condition against a payload.
+func (r RequiredUnlessRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks that the field is present and not empty unless the other field
+// equals any of the listed values (e.g., "required_unless:account_type,personal").
+// Values are compared in their string form, and "null" matches a nil value.
+// Returns an error if the parameters are missing or the field is required but empty.
+func (r RequiredUnlessRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	parts := trimParams(params)
+	other, values := parts[0], parts[1:]
+
+	otherVal, _ := otherValue(data, field, other)
+	if matchesAny(otherVal, values) || !validator.IsEmpty(value) {
+		return nil
+	}
+
+	return validator.NewError(field, "required_unless", ":attribute is required unless :other is in :values", validator.Args{"other": other, "values": strings.Join(values, ", ")})
+}
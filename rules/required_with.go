@@ -0,0 +1,56 @@
+package rules
+
+import "github.com/shivajichalise/validator"
+
+// RequiredWithRule requires a field when any of the other listed fields is present and not empty.
+// Use "required_with:foo,bar" in rule expressions.
+type RequiredWithRule struct {
+	conditionalRule
+}
+
+func init() {
+	validator.RegisterRule(RequiredWithRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "required_with").
+func (r RequiredWithRule) Name() string {
+	return "required_with"
+}
+
+// CheckParams checks that at least one field is listed (e.g., "required_with:street,city").
+func (r RequiredWithRule) CheckParams(field string, params ...string) error {
+	if len(trimParams(params)) == 0 {
+		return validator.NewError(field, "required_with.param", ":attribute: required_with rule requires at least one field", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the condition never holds.
+// Use ValidateData to evaluate the condition against a payload.
+func (r RequiredWithRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks that the field is present and not empty when any of the
+// listed fields is present and not empty (e.g., "required_with:street,city").
+// Returns an error if no fields are listed or the field is required but empty.
+func (r RequiredWithRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	others := trimParams(params)
+
+	if !validator.IsEmpty(value) {
+		return nil
+	}
+
+	for _, other := range others {
+		if isFilled(data, field, other) {
+			return validator.NewError(field, "required_with", ":attribute is required when :others is present", validator.Args{"others": others})
+		}
+	}
+
+	return nil
+}
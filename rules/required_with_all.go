@@ -0,0 +1,56 @@
+package rules
+
+import "github.com/shivajichalise/validator"
+
+// RequiredWithAllRule requires a field when all of the other listed fields are present and not empty.
+// Use "required_with_all:foo,bar" in rule expressions.
+type RequiredWithAllRule struct {
+	conditionalRule
+}
+
+func init() {
+	validator.RegisterRule(RequiredWithAllRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "required_with_all").
+func (r RequiredWithAllRule) Name() string {
+	return "required_with_all"
+}
+
+// CheckParams checks that at least one field is listed (e.g., "required_with_all:first_name,last_name").
+func (r RequiredWithAllRule) CheckParams(field string, params ...string) error {
+	if len(trimParams(params)) == 0 {
+		return validator.NewError(field, "required_with_all.param", ":attribute: required_with_all rule requires at least one field", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the condition never holds.
+// Use ValidateData to evaluate the condition against a payload.
+func (r RequiredWithAllRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks that the field is present and not empty when every one of the
+// listed fields is present and not empty (e.g., "required_with_all:first_name,last_name").
+// Returns an error if no fields are listed or the field is required but empty.
+func (r RequiredWithAllRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	others := trimParams(params)
+
+	if !validator.IsEmpty(value) {
+		return nil
+	}
+
+	for _, other := range others {
+		if !isFilled(data, field, other) {
+			return nil
+		}
+	}
+
+	return validator.NewError(field, "required_with_all", ":attribute is required when :others are present", validator.Args{"others": others})
+}
@@ -0,0 +1,56 @@
+package rules
+
+import "github.com/shivajichalise/validator"
+
+// RequiredWithoutRule requires a field when any of the other listed fields is missing or empty.
+// Use "required_without:foo,bar" in rule expressions.
+type RequiredWithoutRule struct {
+	conditionalRule
+}
+
+func init() {
+	validator.RegisterRule(RequiredWithoutRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "required_without").
+func (r RequiredWithoutRule) Name() string {
+	return "required_without"
+}
+
+// CheckParams checks that at least one field is listed (e.g., "required_without:phone").
+func (r RequiredWithoutRule) CheckParams(field string, params ...string) error {
+	if len(trimParams(params)) == 0 {
+		return validator.NewError(field, "required_without.param", ":attribute: required_without rule requires at least one field", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the field is always required.
+// Use ValidateData to evaluate the condition against a payload.
+func (r RequiredWithoutRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks that the field is present and not empty when any of the
+// listed fields is missing or empty (e.g., "required_without:phone").
+// Returns an error if no fields are listed or the field is required but empty.
+func (r RequiredWithoutRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	others := trimParams(params)
+
+	if !validator.IsEmpty(value) {
+		return nil
+	}
+
+	for _, other := range others {
+		if !isFilled(data, field, other) {
+			return validator.NewError(field, "required_without", ":attribute is required when :others is not present", validator.Args{"others": others})
+		}
+	}
+
+	return nil
+}
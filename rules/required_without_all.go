@@ -0,0 +1,56 @@
+package rules
+
+import "github.com/shivajichalise/validator"
+
+// RequiredWithoutAllRule requires a field when all of the other listed fields are missing or empty.
+// Use "required_without_all:foo,bar" in rule expressions.
+type RequiredWithoutAllRule struct {
+	conditionalRule
+}
+
+func init() {
+	validator.RegisterRule(RequiredWithoutAllRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "required_without_all").
+func (r RequiredWithoutAllRule) Name() string {
+	return "required_without_all"
+}
+
+// CheckParams checks that at least one field is listed (e.g., "required_without_all:phone,email").
+func (r RequiredWithoutAllRule) CheckParams(field string, params ...string) error {
+	if len(trimParams(params)) == 0 {
+		return validator.NewError(field, "required_without_all.param", ":attribute: required_without_all rule requires at least one field", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the field is always required.
+// Use ValidateData to evaluate the condition against a payload.
+func (r RequiredWithoutAllRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks that the field is present and not empty when none of the
+// listed fields is present and not empty (e.g., "required_without_all:phone,email").
+// Returns an error if no fields are listed or the field is required but empty.
+func (r RequiredWithoutAllRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	others := trimParams(params)
+
+	if !validator.IsEmpty(value) {
+		return nil
+	}
+
+	for _, other := range others {
+		if isFilled(data, field, other) {
+			return nil
+		}
+	}
+
+	return validator.NewError(field, "required_without_all", ":attribute is required when none of :others are present", validator.Args{"others": others})
+}
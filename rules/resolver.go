@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+)
+
+// DefaultDNSTimeout bounds the DNS lookups of the email rule when the context has no
+// earlier deadline, so a slow nameserver cannot stall validation indefinitely.
+const DefaultDNSTimeout = 5 * time.Second
+
+// Resolver looks up the DNS records checked by the email rule's "dns" mode.
+// *net.Resolver implements it; FakeResolver is an in-memory implementation for tests.
+// Implementations must respect ctx's deadline and cancellation.
+type Resolver interface {
+	// LookupMX returns the MX records of the domain name.
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+
+	// LookupIPAddr returns the IPv4 and IPv6 addresses (A and AAAA records) of the
+	// host, used as its implicit MX when the domain has no MX records (RFC 5321).
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// FakeResolver is an in-memory Resolver for testing email validation offline.
+// Domain names are matched case-insensitively, with or without a trailing dot.
+//
+//	resolver := rules.FakeResolver{
+//	    MX: map[string][]*net.MX{"astley.com": {{Host: "mx.astley.com.", Pref: 10}}},
+//	    IP: map[string][]net.IPAddr{"rick.dev": {{IP: net.ParseIP("192.0.2.1")}}},
+//	}
+//	validator.ReplaceRule(rules.EmailRule{Resolver: resolver})
+type FakeResolver struct {
+	// MX maps domain names to their MX records. Domains missing from the map
+	// have no MX records.
+	MX map[string][]*net.MX
+
+	// IP maps host names to their addresses. Hosts missing from the map have none.
+	IP map[string][]net.IPAddr
+}
+
+// LookupMX returns the MX records of name, or a *net.DNSError reporting that the
+// domain was not found. It returns ctx's error if ctx is already done.
+func (r FakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return fakeLookup(r.MX, name)
+}
+
+// LookupIPAddr returns the addresses of host, or a *net.DNSError reporting that the
+// host was not found. It returns ctx's error if ctx is already done.
+func (r FakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return fakeLookup(r.IP, host)
+}
+
+// fakeLookup returns the records of name in records, matching names case-insensitively.
+func fakeLookup[T any](records map[string][]T, name string) ([]T, error) {
+	for domain, found := range records {
+		if normalizeDomain(domain) == normalizeDomain(name) {
+			return found, nil
+		}
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+// normalizeDomain lowercases a domain name and strips its trailing dot.
+func normalizeDomain(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/shivajichalise/validator"
+)
+
+// SameRule validates that a field has the same value as another field.
+// Use "same:other" in rule expressions.
+type SameRule struct{}
+
+func init() {
+	validator.RegisterRule(SameRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "same").
+func (r SameRule) Name() string {
+	return "same"
+}
+
+// CheckParams checks that the other field is given (e.g., "same:password").
+func (r SameRule) CheckParams(field string, params ...string) error {
+	if len(params) == 0 || strings.TrimSpace(params[0]) == "" {
+		return validator.NewError(field, "same.param", ":attribute: same rule requires a field to compare with", nil)
+	}
+
+	return nil
+}
+
+// Validate runs the rule without access to other fields, so the other field is treated as missing.
+// Use ValidateData to compare against a payload.
+func (r SameRule) Validate(field string, value any, params ...string) error {
+	return r.ValidateData(validator.NewData(nil), field, value, params...)
+}
+
+// ValidateData checks whether the value equals the value of the other field (e.g., "same:password").
+// Numeric values are compared by value, so 5 and 5.0 match.
+// Returns an error if the parameter is missing or the values differ.
+func (r SameRule) ValidateData(data validator.Data, field string, value any, params ...string) error {
+	if err := r.CheckParams(field, params...); err != nil {
+		return err
+	}
+
+	other := params[0]
+	otherVal, _ := otherValue(data, field, other)
+
+	if !equalValues(value, otherVal) {
+		return validator.NewError(field, "same", ":attribute and :other must match", validator.Args{"other": other})
+	}
+
+	return nil
+}
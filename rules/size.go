@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/shivajichalise/validator"
+)
+
+// SizeRule validates that the size of a value equals a specified size:
+// the length of a string, the value of a number, the number of elements of a
+// slice, array or map, or the size in bytes of a file.
+// Use "size:n" in rule expressions (e.g., "size:10").
+type SizeRule struct{}
+
+// sizeMessages holds the message of a failed "size" rule for each way of measuring the value.
+var sizeMessages = map[sizeKind]string{
+	sizeCharacters: ":attribute must be :size characters",
+	sizeBytes:      ":attribute must be :size bytes",
+	sizeNumeric:    ":attribute must be :size",
+	sizeArray:      ":attribute must contain :size items",
+	sizeFile:       ":attribute must be :size bytes",
+}
+
+func init() {
+	validator.RegisterRule(SizeRule{})
+}
+
+// Name returns the name of the rule used in rule expressions (e.g., "size").
+func (r SizeRule) Name() string {
+	return "size"
+}
+
+// CheckParams checks that the size is given as a number, optionally followed
+// by a length mode for strings (e.g., "size:10" or "size:10,bytes").
+func (r SizeRule) CheckParams(field string, params ...string) error {
+	_, err := parseSizeParams(field, r.Name(), params)
+	return err
+}
+
+// Validate checks whether the size of the value is equal to the given size (e.g., "size:10").
+// Values are measured as described on measure; see lengthMode for string length modes.
+// Returns an error if the value has no size, the parameters are malformed, or the size differs.
+func (r SizeRule) Validate(field string, value any, params ...string) error {
+	return validateSize(field, r.Name(), value, params, func(cmp int) bool {
+		return cmp == 0
+	}, sizeMessages)
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"unicode"
+)
+
+// scriptSets lists the combinations of scripts that are commonly written together,
+// following the "highly restrictive" level of Unicode Technical Standard #39:
+// Japanese, Chinese and Korean text mix Han with other scripts, and Latin letters
+// appear alongside all of them.
+var scriptSets = []map[string]bool{
+	{"Latin": true, "Han": true, "Hiragana": true, "Katakana": true},
+	{"Latin": true, "Han": true, "Bopomofo": true},
+	{"Latin": true, "Han": true, "Hangul": true},
+}
+
+// isMixedScript reports whether s mixes letters of scripts that are not normally
+// written together, such as Latin and Cyrillic in "p\u0430ypal" (with a Cyrillic "а"),
+// a common way to imitate another address. Digits, punctuation and combining marks
+// belong to no particular script and are ignored.
+func isMixedScript(s string) bool {
+	scripts := make(map[string]bool)
+	for _, r := range s {
+		if script, ok := scriptOf(r); ok {
+			scripts[script] = true
+		}
+	}
+
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	for _, set := range scriptSets {
+		if isSubset(scripts, set) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scriptOf returns the Unicode script of r. Returns false for characters shared by
+// all scripts (the Common and Inherited scripts), such as digits and punctuation.
+func scriptOf(r rune) (string, bool) {
+	if r < 0x80 {
+		if unicode.IsLetter(r) {
+			return "Latin", true
+		}
+		return "", false
+	}
+
+	for name, table := range unicode.Scripts {
+		if name == "Common" || name == "Inherited" {
+			continue
+		}
+		if unicode.Is(table, r) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// isSubset reports whether every key of a is in b.
+func isSubset(a, b map[string]bool) bool {
+	for key := range a {
+		if !b[key] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/shivajichalise/validator"
@@ -23,13 +22,13 @@ func (r StringRule) Name() string {
 // Validate checks whether the given value is a string and not empty after trimming whitespace.
 // Returns an error if the value is not a string or is empty.
 func (r StringRule) Validate(field string, value any, _ ...string) error {
-	str, ok := value.(string)
+	str, ok := validator.Deref(value).(string)
 	if !ok {
-		return fmt.Errorf("%s field must be a valid string", field)
+		return validator.NewError(field, "string", ":attribute field must be a valid string", nil)
 	}
 
 	if strings.TrimSpace(str) == "" {
-		return fmt.Errorf("%s must not be empty", field)
+		return validator.NewError(field, "string.empty", ":attribute must not be empty", nil)
 	}
 
 	return nil
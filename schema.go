@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"context"
+	"errors"
+)
+
+// Schema is a set of rules parsed and resolved once by Compile, for validating
+// many payloads without re-parsing rule expressions. A Schema is immutable and
+// safe for concurrent use by multiple goroutines.
+type Schema struct {
+	fields []compiledField
+	opts   []Option
+}
+
+// compiledField holds the compiled rules of one rules key.
+type compiledField struct {
+	pattern  string
+	bail     bool
+	presence presence
+	rules    []compiledRule
+}
+
+// compiledRule is a rule expression resolved against a registry.
+// rule is nil if the expression names an unregistered rule, and err is set
+// if the expression could not be parsed. extraParams is set if the expression
+// gives parameters to a rule handled by the validator, such as "required:yes".
+type compiledRule struct {
+	expr        string
+	name        string
+	params      []string
+	rule        Rule
+	implicit    bool
+	extraParams bool
+	err         *SyntaxError
+}
+
+// SchemaError describes a rule expression rejected by Compile, because it is
+// malformed, names an unregistered rule or has malformed parameters.
+type SchemaError struct {
+	// Field is the rules key the expression was declared on, e.g. "items.*.price".
+	Field string
+
+	// Rule is the offending rule expression, e.g. "min:abc".
+	Rule string
+
+	// Err describes the problem. It is a *SyntaxError, a *RuleError with code
+	// CodeUnknownRule, or the error returned by the rule's CheckParams.
+	Err error
+}
+
+// Error returns the description of the problem, prefixed with the package name.
+func (e *SchemaError) Error() string {
+	return "validator: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+// Compile parses the rule expressions and resolves them against the registry,
+// which is the default registry unless WithRegistry is given. The options are
+// applied to every validation run by the Schema. Fields are validated in the
+// order of their rules keys; use CompileOrdered to choose the order.
+// If any expression is malformed (see ParseRules), or any rule is unknown or has
+// malformed parameters (see ParamRule), Compile
+// returns nil and an error joining a *SchemaError for each of them.
+func Compile(rules rules, opts ...Option) (*Schema, error) {
+	return CompileOrdered(sortedFields(rules), opts...)
+}
+
+// CompileOrdered compiles an ordered rule definition like Compile. Fields are
+// validated, and their errors reported, in the order they are declared.
+func CompileOrdered(fields []FieldRules, opts ...Option) (*Schema, error) {
+	v := MakeOrdered(nil, fields, opts...)
+	compiled := v.compile()
+
+	var errs []error
+	for _, f := range compiled {
+		for _, cr := range f.rules {
+			if err := checkRule(f.pattern, cr); err != nil {
+				errs = append(errs, &SchemaError{Field: f.pattern, Rule: cr.expr, Err: err})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return &Schema{fields: compiled, opts: opts}, nil
+}
+
+// Validate validates data against the Schema.
+// Returns nil if validation passes, otherwise every failure in the order it was found.
+func (s *Schema) Validate(data map[string]any) ValidationErrors {
+	return s.ValidateContext(context.Background(), data)
+}
+
+// ValidateContext is like Validate, but passes ctx to rules implementing ContextRule
+// (see Validator.ValidateContext).
+func (s *Schema) ValidateContext(ctx context.Context, data map[string]any) ValidationErrors {
+	v := MakeOrdered(data, nil, s.opts...)
+	v.compiled = s.fields
+	v.ValidateContext(ctx)
+
+	return v.fieldErrors
+}
+
+// compile parses the Validator's rule expressions and resolves them against its registry.
+// Rules are kept in the order they are declared. Malformed expressions, unknown rules
+// and parameters given to "bail" or a presence rule are kept so Validate can report
+// them as field errors.
+func (v *Validator) compile() []compiledField {
+	fields := make([]compiledField, 0, len(v.fields))
+	for _, fr := range v.fields {
+		f := compiledField{pattern: fr.Field}
+
+		var parsed []ParsedRule
+		for _, expr := range fr.Rules {
+			rules, err := ParseRules(expr)
+			if err != nil {
+				f.rules = append(f.rules, compiledRule{expr: expr, err: err.(*SyntaxError)})
+				continue
+			}
+			parsed = append(parsed, rules...)
+
+			for _, pr := range rules {
+				if isValidatorRule(pr.Name) {
+					if len(pr.Params) > 0 {
+						f.rules = append(f.rules, compiledRule{expr: pr.Expr, name: pr.Name, params: pr.Params, extraParams: true})
+					}
+					continue
+				}
+
+				rule, _ := v.getRule(pr.Name)
+
+				f.rules = append(f.rules, compiledRule{
+					expr:     pr.Expr,
+					name:     pr.Name,
+					params:   pr.Params,
+					rule:     rule,
+					implicit: rule != nil && isImplicit(rule),
+				})
+			}
+		}
+
+		f.bail, parsed = parseBail(parsed)
+		f.presence, _ = parsePresence(parsed)
+
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// checkRule reports whether a compiled rule was parsed and names a registered rule with valid
+// parameters, or a rule handled by the validator without parameters.
+func checkRule(pattern string, cr compiledRule) error {
+	if cr.err != nil {
+		return cr.err
+	}
+
+	if cr.extraParams {
+		return extraParamsError(pattern, cr.name)
+	}
+
+	if cr.rule == nil {
+		return NewError(pattern, CodeUnknownRule, ":attribute: rule ':rule' not found", Args{"rule": cr.name})
+	}
+
+	if paramRule, ok := cr.rule.(ParamRule); ok {
+		return paramRule.CheckParams(pattern, cr.params...)
+	}
+
+	return nil
+}
+
+// extraParamsError returns the error for parameters given to "bail" or a presence rule,
+// which take none (e.g., "required:yes").
+func extraParamsError(field, name string) error {
+	return NewError(field, name+".param", ":attribute: :rule rule does not take parameters", Args{"rule": name})
+}
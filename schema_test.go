@@ -0,0 +1,225 @@
+package validator_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    map[string][]string
+		wantCode string
+	}{
+		{
+			name:  "valid rules compile",
+			rules: map[string][]string{"age": {"bail", "required", "int", "between:18,99"}, "email": {"sometimes", "email:rfc"}},
+		},
+		{
+			name:     "unknown rule",
+			rules:    map[string][]string{"name": {"required", "slugg"}},
+			wantCode: validator.CodeUnknownRule,
+		},
+		{
+			name:     "non-numeric length",
+			rules:    map[string][]string{"name": {"string", "min:five"}},
+			wantCode: "min.param",
+		},
+		{
+			name:     "missing between bound",
+			rules:    map[string][]string{"age": {"between:18"}},
+			wantCode: "between.param",
+		},
+		{
+			name:     "missing comparison value",
+			rules:    map[string][]string{"age": {"gt"}},
+			wantCode: "gt.param",
+		},
+		{
+			name:     "required with parameters",
+			rules:    map[string][]string{"name": {"required:yes", "string"}},
+			wantCode: "required.param",
+		},
+		{
+			name:     "nullable with parameters",
+			rules:    map[string][]string{"age": {"nullable:1|int"}},
+			wantCode: "nullable.param",
+		},
+		{
+			name:     "bail with parameters",
+			rules:    map[string][]string{"age": {"bail:x", "int"}},
+			wantCode: "bail.param",
+		},
+		{
+			name:     "sometimes with parameters",
+			rules:    map[string][]string{"email": {"sometimes:now", "email"}},
+			wantCode: "sometimes.param",
+		},
+		{
+			name:     "required_if without values",
+			rules:    map[string][]string{"company_name": {"required_if:account_type"}},
+			wantCode: "required_if.param",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := validator.Compile(tt.rules)
+
+			if tt.wantCode == "" {
+				if err != nil || schema == nil {
+					t.Fatalf("expected rules to compile, got: %v", err)
+				}
+				return
+			}
+
+			var schemaErr *validator.SchemaError
+			if !errors.As(err, &schemaErr) {
+				t.Fatalf("expected a SchemaError, got: %v", err)
+			}
+
+			var ruleErr *validator.RuleError
+			if !errors.As(schemaErr, &ruleErr) || ruleErr.Code != tt.wantCode {
+				t.Errorf("expected code %q, got: %v", tt.wantCode, err)
+			}
+
+			if schema != nil {
+				t.Error("expected no schema when compilation fails")
+			}
+		})
+	}
+}
+
+func TestCompileReportsEveryError(t *testing.T) {
+	_, err := validator.Compile(map[string][]string{
+		"age":  {"int", "max:old"},
+		"name": {"slugg"},
+	})
+
+	want := "validator: age: max value must be a valid number\nvalidator: name: rule 'slugg' not found"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got: %v", want, err)
+	}
+}
+
+func TestCompileReportsErrorsInDeclaredOrder(t *testing.T) {
+	rules := map[string][]string{"age": {"slugg", `int|max:"5`, "filled:x"}}
+
+	_, err := validator.Compile(rules)
+
+	want := "validator: age: rule 'slugg' not found\n" +
+		`validator: syntax error in rule expression "int|max:\"5" at column 9: unterminated quoted parameter` + "\n" +
+		"validator: age: filled rule does not take parameters"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got: %v", want, err)
+	}
+
+	v := validator.Make(map[string]any{"age": "old"}, rules)
+	v.Validate()
+
+	var codes []string
+	for _, fe := range v.FieldErrors() {
+		codes = append(codes, fe.Code)
+	}
+
+	if want := []string{validator.CodeUnknownRule, validator.CodeInvalidRule, "filled.param"}; !reflect.DeepEqual(codes, want) {
+		t.Errorf("expected codes %v, got %v", want, codes)
+	}
+}
+
+func TestCompileWithRegistry(t *testing.T) {
+	fieldRules := map[string][]string{"slug": {"string", "slug"}}
+
+	if _, err := validator.Compile(fieldRules); err == nil {
+		t.Error("expected slug to be unknown in the default registry")
+	}
+
+	registry := validator.DefaultRegistry().Clone()
+	if err := registry.Register(slugRule{sep: "-"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema, err := validator.Compile(fieldRules, validator.WithRegistry(registry))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Rules are resolved at compile time, so later registry changes do not affect the schema.
+	registry.Unregister("slug")
+
+	if errs := schema.Validate(map[string]any{"slug": "never-gonna"}); errs != nil {
+		t.Errorf("expected slug to pass, got: %v", errs)
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := validator.Compile(map[string][]string{
+		"name":          {"required", "string", "min:3"},
+		"items.*.price": {"required", "numeric", "gt:0"},
+	}, validator.WithAttributes(map[string]string{"items.*.price": "price"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	valid := map[string]any{
+		"name":  "Rick",
+		"items": []any{map[string]any{"price": 10}},
+	}
+	if errs := schema.Validate(valid); errs != nil {
+		t.Errorf("expected valid payload to pass, got: %v", errs)
+	}
+
+	invalid := map[string]any{
+		"name":  "Ri",
+		"items": []any{map[string]any{"price": 10}, map[string]any{}},
+	}
+	errs := schema.Validate(invalid)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got: %v", errs)
+	}
+
+	if got := errs.Field("items.1.price"); len(got) != 1 || got[0].Message != "price is required" {
+		t.Errorf("expected display name in message, got: %v", got)
+	}
+
+	// Each run starts from a clean slate.
+	if errs := schema.Validate(valid); errs != nil {
+		t.Errorf("expected errors not to carry over between runs, got: %v", errs)
+	}
+}
+
+func TestSchemaConcurrentUse(t *testing.T) {
+	schema, err := validator.Compile(map[string][]string{
+		"age":      {"required", "int", "gt:17"},
+		"password": {"required", "string", "confirmed"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(age int) {
+			defer wg.Done()
+
+			data := map[string]any{
+				"age":                   age,
+				"password":              fmt.Sprint("secret", age),
+				"password_confirmation": fmt.Sprint("secret", age),
+			}
+
+			errs := schema.Validate(data)
+			if wantValid := age > 17; (errs == nil) != wantValid {
+				t.Errorf("age %d: expected valid: %v, got: %v", age, wantValid, errs)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
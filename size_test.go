@@ -0,0 +1,150 @@
+package validator_test
+
+import (
+	"bytes"
+	"io/fs"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestSizeRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		rules    []string
+		wantCode string
+	}{
+		{name: "string length within bounds", value: "rickastley", rules: []string{"min:5", "max:10", "size:10"}},
+		{name: "string too long", value: "rickastley", rules: []string{"max:9"}, wantCode: "max"},
+		{name: "string wrong size", value: "rick", rules: []string{"size:5"}, wantCode: "size"},
+		{name: "string size in bytes", value: "नेपाल", rules: []string{"size:15,bytes"}},
+		{name: "string wrong size in bytes", value: "नेपाल", rules: []string{"size:5,bytes"}, wantCode: "size.bytes"},
+		{name: "int bounds are inclusive", value: 18, rules: []string{"min:18", "max:18", "size:18"}},
+		{name: "int below minimum", value: 17, rules: []string{"min:18"}, wantCode: "min.numeric"},
+		{name: "int above maximum", value: int64(120), rules: []string{"max:99"}, wantCode: "max.numeric"},
+		{name: "float with decimal bound", value: 9.99, rules: []string{"min:0.01", "max:9.99"}},
+		{name: "float above decimal bound", value: 10.5, rules: []string{"max:9.99"}, wantCode: "max.numeric"},
+		{name: "negative bound", value: -5, rules: []string{"min:-10"}},
+		{name: "pointer to number", value: ptr(3), rules: []string{"size:3"}},
+		{name: "slice item count", value: []string{"a", "b"}, rules: []string{"min:1", "max:2", "size:2"}},
+		{name: "slice too few items", value: []any{}, rules: []string{"min:1"}, wantCode: "min.array"},
+		{name: "array item count", value: [3]int{1, 2, 3}, rules: []string{"size:3"}},
+		{name: "map key count", value: map[string]int{"a": 1, "b": 2, "c": 3}, rules: []string{"max:2"}, wantCode: "max.array"},
+		{name: "file header size", value: &multipart.FileHeader{Filename: "cv.pdf", Size: 2048}, rules: []string{"min:1024", "max:4096"}},
+		{name: "file header too large", value: &multipart.FileHeader{Filename: "cv.pdf", Size: 8192}, rules: []string{"max:4096"}, wantCode: "max.file"},
+		{name: "file info size", value: fileInfo("never gonna"), rules: []string{"size:11"}},
+		{name: "reader is not a file", value: strings.NewReader("never gonna"), rules: []string{"size:11"}, wantCode: "size.type"},
+		{name: "bool has no size", value: true, rules: []string{"min:1"}, wantCode: "min.type"},
+		{name: "nil has no size", value: nil, rules: []string{"max:1"}, wantCode: "max.type"},
+		{name: "typed nil reader has no size", value: (*bytes.Reader)(nil), rules: []string{"max:10"}, wantCode: "max.type"},
+		{name: "typed nil file header has no size", value: (*multipart.FileHeader)(nil), rules: []string{"min:1"}, wantCode: "min.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(map[string]any{"field": tt.value}, map[string][]string{"field": tt.rules})
+			v.Validate()
+
+			assertCode(t, v.FieldErrors(), tt.wantCode)
+		})
+	}
+}
+
+func TestSizeRuleMessages(t *testing.T) {
+	tests := []struct {
+		value any
+		rule  string
+		want  string
+	}{
+		{value: "rick", rule: "min:5", want: "name must be at least 5 characters"},
+		{value: 17, rule: "min:18", want: "name must be at least 18"},
+		{value: []int{1, 2, 3}, rule: "max:2", want: "name must not have more than 2 items"},
+		{value: []int{1}, rule: "min:1.5", want: "name must have at least 1.5 items"},
+		{value: []int{1, 2}, rule: "size:1", want: "name must contain 1 item"},
+		{value: &multipart.FileHeader{Size: 10}, rule: "size:1", want: "name must be 1 byte"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			v := validator.Make(map[string]any{"name": tt.value}, map[string][]string{"name": {tt.rule}})
+			v.Validate()
+
+			if got := v.Errors()["name"]; len(got) != 1 || got[0] != tt.want {
+				t.Errorf("expected %q, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFileSizeInStructsAndNestedData(t *testing.T) {
+	type upload struct {
+		File *multipart.FileHeader `json:"file" validate:"max:100"`
+	}
+
+	for size, want := range map[int64]int{50: 0, 500: 1} {
+		v, err := validator.MakeStruct(upload{File: &multipart.FileHeader{Size: size}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		v.Validate()
+
+		if errs := v.FieldErrors(); len(errs) != want || (want > 0 && errs[0].Code != "max.file") {
+			t.Errorf("size %d: expected %d max.file errors, got: %v", size, want, errs)
+		}
+	}
+
+	v := validator.Make(
+		map[string]any{"items": []any{
+			map[string]any{"file": &multipart.FileHeader{Size: 500}},
+			map[string]any{"file": &multipart.FileHeader{Size: 50}},
+			map[string]any{"file": fileInfo("never gonna give you up")},
+		}},
+		map[string][]string{"items.*.file": {"max:20"}},
+	)
+	v.Validate()
+
+	var got []string
+	for _, fe := range v.FieldErrors() {
+		got = append(got, fe.Field+" "+fe.Code)
+	}
+
+	if want := []string{"items.0.file max.file", "items.1.file max.file", "items.2.file max.file"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// fileInfo returns the fs.FileInfo of an in-memory file holding data.
+func fileInfo(data string) fs.FileInfo {
+	info, err := fs.Stat(fstest.MapFS{"file": {Data: []byte(data)}}, "file")
+	if err != nil {
+		panic(err)
+	}
+	return info
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// assertCode checks that errs holds exactly one error with the code want, or no
+// errors if want is empty.
+func assertCode(t *testing.T, errs validator.ValidationErrors, want string) {
+	t.Helper()
+
+	if want == "" {
+		if len(errs) > 0 {
+			t.Errorf("expected no errors, got: %v", errs)
+		}
+		return
+	}
+
+	if len(errs) != 1 || errs[0].Code != want {
+		t.Errorf("expected one %q error, got: %v", want, errs)
+	}
+}
@@ -0,0 +1,242 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structTag is the struct tag holding a field's rule expressions (e.g. `validate:"string|min:5"`).
+const structTag = "validate"
+
+// MakeStruct creates a new Validator from the exported fields of a struct.
+// Each field's rules are read from its `validate` tag, a rule expression
+// separated by '|' (e.g. `validate:"string|min:5"`; see ParseRules). A field is reported under its `json` tag
+// name when present, otherwise under its Go field name. Fields tagged with
+// `validate:"-"` or `json:"-"` are ignored.
+//
+// Embedded structs are flattened into their parent and pointer fields are
+// dereferenced, with nil pointers validated as nil values. As in Go and
+// encoding/json, a field promoted from an embedded struct is hidden by a field of
+// the same name declared less deeply. Fields are validated in the order they are
+// declared.
+// Options are applied as in Make.
+// Returns an error if s is not a struct or a non-nil pointer to a struct.
+func MakeStruct(s any, opts ...Option) (*Validator, error) {
+	rv, err := structValue(s)
+	if err != nil {
+		return nil, err
+	}
+
+	d := make(data)
+	var fields []FieldRules
+	collectValues(rv, d)
+	collectRules(rv.Type(), "", &fields, nil)
+
+	return MakeOrdered(d, fields, opts...), nil
+}
+
+// ValidateStruct validates the exported fields of a struct using their `validate` tags.
+// See MakeStruct for how tags are read.
+// Returns nil errors if validation passes, or an error if s is not a struct.
+func ValidateStruct(s any, opts ...Option) (Errors, error) {
+	v, err := MakeStruct(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Validate() {
+		return nil, nil
+	}
+
+	return v.Errors(), nil
+}
+
+// structValue unwraps pointers and returns the underlying struct value.
+func structValue(s any) (reflect.Value, error) {
+	rv := reflect.ValueOf(s)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("validator: cannot validate nil %T", s)
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("validator: expected a struct, got %T", s)
+	}
+
+	return rv, nil
+}
+
+// collectValues records the value of every exported field of rv under its reported name.
+// Embedded structs are flattened into d (see visibleFields).
+func collectValues(rv reflect.Value, d data) {
+	for _, f := range visibleFields(rv.Type()) {
+		if f.field.Tag.Get(structTag) == "-" {
+			continue
+		}
+
+		fv, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			continue // promoted through a nil embedded pointer
+		}
+
+		d[f.name] = valueOf(fv)
+	}
+}
+
+// collectRules walks the exported fields of rt and records the rule expressions of
+// every tagged field under its path, prefixed with prefix. Nested structs contribute
+// dotted paths (e.g. "address.city") and slices, arrays and maps of structs contribute
+// wildcard paths (e.g. "items.*.price"). Embedded structs are flattened (see visibleFields).
+// Fields are appended in declaration order. seen guards against infinitely recursive types.
+func collectRules(rt reflect.Type, prefix string, fields *[]FieldRules, seen map[reflect.Type]bool) {
+	if seen[rt] {
+		return
+	}
+
+	seen = withType(seen, rt)
+
+	for _, f := range visibleFields(rt) {
+		tag := f.field.Tag.Get(structTag)
+		if tag == "-" {
+			continue
+		}
+
+		path := prefix + f.name
+		if tag != "" {
+			*fields = append(*fields, FieldRules{Field: path, Rules: []string{tag}})
+		}
+
+		nested := elemType(f.field.Type)
+		switch nested.Kind() {
+		case reflect.Struct:
+			collectRules(nested, path+pathSeparator, fields, seen)
+		case reflect.Slice, reflect.Array, reflect.Map:
+			item := elemType(nested.Elem())
+			if item.Kind() == reflect.Struct {
+				collectRules(item, path+pathSeparator+wildcard+pathSeparator, fields, seen)
+			}
+		}
+	}
+}
+
+// visibleField is a field of a struct, possibly promoted from an embedded struct,
+// and the name it is reported under.
+type visibleField struct {
+	name  string
+	field reflect.StructField
+	index []int // the index sequence for reflect.Value.FieldByIndex
+	depth int   // the number of embedded structs the field is promoted through
+}
+
+// visibleFields returns the exported fields of rt that are reported by name, with the
+// fields of embedded structs promoted, in declaration order. Embedded structs with a
+// `json` tag name are reported as a single field, like any other field.
+//
+// As in Go and encoding/json, a field is hidden by a field of the same name declared
+// at a shallower depth, so an outer field is never replaced by a promoted one. Of
+// several fields with the same name at the same depth, the last declared is kept.
+func visibleFields(rt reflect.Type) []visibleField {
+	var all []visibleField
+	promoteFields(rt, nil, 0, map[reflect.Type]bool{}, &all)
+
+	visible := make(map[string]int, len(all)) // name -> index in all of the field kept
+	var order []string
+	for i, f := range all {
+		kept, ok := visible[f.name]
+		switch {
+		case !ok:
+			order = append(order, f.name)
+			visible[f.name] = i
+		case f.depth <= all[kept].depth:
+			visible[f.name] = i
+		}
+	}
+
+	fields := make([]visibleField, 0, len(order))
+	for _, name := range order {
+		fields = append(fields, all[visible[name]])
+	}
+
+	return fields
+}
+
+// promoteFields appends the named exported fields of rt to fields, recursing into
+// embedded structs. index is the index sequence of rt, and depth its embedding depth.
+// seen guards against embedded structs that embed themselves through a pointer.
+func promoteFields(rt reflect.Type, index []int, depth int, seen map[reflect.Type]bool, fields *[]visibleField) {
+	if seen[rt] {
+		return
+	}
+	seen[rt] = true
+	defer delete(seen, rt)
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		if sf.Anonymous && !hasJSONName(sf) {
+			embedded := elemType(sf.Type)
+			if embedded.Kind() == reflect.Struct {
+				promoteFields(embedded, fieldIndex, depth+1, seen, fields)
+				continue
+			}
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, ok := fieldName(sf)
+		if !ok {
+			continue
+		}
+
+		*fields = append(*fields, visibleField{name: name, field: sf, index: fieldIndex, depth: depth})
+	}
+}
+
+// withType returns a copy of seen that also contains rt.
+func withType(seen map[reflect.Type]bool, rt reflect.Type) map[reflect.Type]bool {
+	next := make(map[reflect.Type]bool, len(seen)+1)
+	for t := range seen {
+		next[t] = true
+	}
+	next[rt] = true
+
+	return next
+}
+
+// elemType unwraps pointer types around rt.
+func elemType(rt reflect.Type) reflect.Type {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+
+	return rt
+}
+
+// fieldName returns the name a struct field is reported under.
+// It prefers the `json` tag name and falls back to the Go field name.
+// Returns false if the field is excluded with `json:"-"`.
+func fieldName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return sf.Name, true
+	}
+
+	return name, true
+}
+
+// hasJSONName reports whether a struct field has an explicit `json` tag name.
+func hasJSONName(sf reflect.StructField) bool {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	return name != ""
+}
@@ -0,0 +1,170 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+type Audit struct {
+	CreatedBy string `json:"created_by" validate:"string"`
+}
+
+type Profile struct {
+	Audit
+	Username string   `json:"username,omitempty" validate:"string|min:5|max:20"`
+	Age      *int     `json:"age" validate:"int|gt:18"`
+	Rating   float64  `validate:"float64"`
+	Nickname string   `json:"-" validate:"string"`
+	Internal string   `json:"internal"`
+	Ignored  string   `json:"ignored" validate:"-"`
+	Balance  *float64 `json:"balance" validate:"numeric"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	age := 21
+	young := 16
+	balance := 12.5
+
+	tests := []struct {
+		name       string
+		input      any
+		wantErr    bool
+		wantFields []string
+	}{
+		{
+			name: "valid struct",
+			input: Profile{
+				Audit:    Audit{CreatedBy: "rick"},
+				Username: "rickastley",
+				Age:      &age,
+				Rating:   4.5,
+				Balance:  &balance,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid pointer to struct",
+			input: &Profile{
+				Audit:    Audit{CreatedBy: "rick"},
+				Username: "rickastley",
+				Age:      &age,
+				Rating:   4.5,
+				Balance:  &balance,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid fields reported by json name",
+			input: Profile{
+				Audit:    Audit{CreatedBy: "rick"},
+				Username: "rick",
+				Age:      &young,
+				Rating:   4.5,
+				Balance:  &balance,
+			},
+			wantErr:    true,
+			wantFields: []string{"username", "age"},
+		},
+		{
+			name: "embedded struct fields are validated",
+			input: Profile{
+				Username: "rickastley",
+				Age:      &age,
+				Rating:   4.5,
+				Balance:  &balance,
+			},
+			wantErr:    true,
+			wantFields: []string{"created_by"},
+		},
+		{
+			name: "nil pointer field fails",
+			input: Profile{
+				Audit:    Audit{CreatedBy: "rick"},
+				Username: "rickastley",
+				Rating:   4.5,
+				Balance:  nil,
+				Age:      &age,
+			},
+			wantErr:    true,
+			wantFields: []string{"balance"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validator.ValidateStruct(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (errs != nil) != tt.wantErr {
+				t.Fatalf("expected errors: %v, got: %v", tt.wantErr, errs)
+			}
+
+			for _, field := range tt.wantFields {
+				if _, ok := errs[field]; !ok {
+					t.Errorf("expected error for field %q, got: %v", field, errs)
+				}
+			}
+		})
+	}
+}
+
+type Base struct {
+	Name  int    `validate:"int"`
+	Email string `validate:"email"`
+}
+
+type Outer struct {
+	Name string `validate:"string"`
+	Base
+}
+
+type Untagged struct {
+	*Base
+	Name string
+}
+
+func TestValidateStructShadowsPromotedFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      any
+		wantFields []string
+	}{
+		{name: "outer field rules apply", input: Outer{Base: Base{Email: "rick@astley.com"}}, wantFields: []string{"Name"}},
+		{name: "promoted field rules do not apply", input: Outer{Name: "rick", Base: Base{Email: "rick@astley.com"}}},
+		{name: "other promoted fields are validated", input: Outer{Name: "rick"}, wantFields: []string{"Email"}},
+		{name: "untagged outer field hides tagged promoted field", input: Untagged{Base: &Base{Email: "rick@astley.com"}, Name: "rick"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := validator.ValidateStruct(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("expected errors for %v, got: %v", tt.wantFields, errs)
+			}
+
+			for _, field := range tt.wantFields {
+				if _, ok := errs[field]; !ok {
+					t.Errorf("expected error for field %q, got: %v", field, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStructRejectsNonStruct(t *testing.T) {
+	var nilProfile *Profile
+
+	for _, input := range []any{nil, 42, "rick", nilProfile} {
+		if _, err := validator.ValidateStruct(input); err == nil {
+			t.Errorf("expected error for input %#v", input)
+		}
+	}
+}
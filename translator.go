@@ -0,0 +1,269 @@
+package validator
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the locale used when a Validator has none, and the fallback
+// for messages missing from another locale's catalog.
+const DefaultLocale = "en"
+
+// Plural categories used to select between the forms of a Message.
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+// langFS holds the built-in message catalogs, one <locale>.json file per locale.
+//
+//go:embed lang/*.json
+var langFS embed.FS
+
+// defaultTranslator is used by validators that are not given a Translator.
+var defaultTranslator = NewTranslator()
+
+// PluralRule returns the plural category (e.g. PluralOne or PluralOther) for a count.
+type PluralRule func(n float64) string
+
+// Message is a catalog entry: either a single message template, or plural forms
+// keyed by plural category and selected by the value of the Count argument.
+//
+// In JSON a Message is either a string or an object such as:
+//
+//	{"count": "min", "one": ":attribute must be at least :min character", "other": ":attribute must be at least :min characters"}
+type Message struct {
+	// Count names the argument whose value selects the plural form, e.g. "min".
+	Count string
+
+	// Forms maps plural categories to message templates. PluralOther is used
+	// when the selected category has no form or the count is not numeric.
+	Forms map[string]string
+}
+
+// UnmarshalJSON decodes a Message from a JSON string or object of plural forms.
+func (m *Message) UnmarshalJSON(b []byte) error {
+	var template string
+	if err := json.Unmarshal(b, &template); err == nil {
+		m.Forms = map[string]string{PluralOther: template}
+		return nil
+	}
+
+	var forms map[string]string
+	if err := json.Unmarshal(b, &forms); err != nil {
+		return fmt.Errorf("validator: message must be a string or an object of plural forms: %w", err)
+	}
+
+	m.Count = forms["count"]
+	delete(forms, "count")
+
+	if _, ok := forms[PluralOther]; !ok {
+		return fmt.Errorf("validator: plural message is missing the %q form", PluralOther)
+	}
+
+	m.Forms = forms
+	return nil
+}
+
+// template selects the message template for the given arguments.
+func (m Message) template(args Args, plural PluralRule) string {
+	if m.Count != "" {
+		n, ok := countOf(args[m.Count])
+		if ok {
+			if form, ok := m.Forms[plural(n)]; ok {
+				return form
+			}
+		}
+	}
+
+	return m.Forms[PluralOther]
+}
+
+// Catalog maps error codes (e.g. "min" or "email.dns") to messages for one locale.
+type Catalog map[string]Message
+
+// Translator holds the message catalogs and plural rules for every locale.
+// It is safe for concurrent use.
+type Translator struct {
+	mu       sync.RWMutex
+	catalogs map[string]Catalog
+	plurals  map[string]PluralRule
+}
+
+// NewTranslator returns a Translator preloaded with the built-in catalogs
+// (English, German and Nepali).
+func NewTranslator() *Translator {
+	t := &Translator{
+		catalogs: make(map[string]Catalog),
+		plurals:  make(map[string]PluralRule),
+	}
+
+	if err := t.LoadFS(langFS, "lang"); err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+// DefaultTranslator returns the Translator used by validators that are not given one.
+// Catalogs added to it apply to every such validator.
+func DefaultTranslator() *Translator {
+	return defaultTranslator
+}
+
+// AddCatalog merges messages into the catalog of a locale, replacing existing
+// messages with the same code.
+func (t *Translator) AddCatalog(locale string, catalog Catalog) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	existing, ok := t.catalogs[locale]
+	if !ok {
+		existing = make(Catalog, len(catalog))
+		t.catalogs[locale] = existing
+	}
+
+	for code, message := range catalog {
+		existing[code] = message
+	}
+}
+
+// LoadJSON decodes a JSON catalog and merges it into the catalog of a locale.
+func (t *Translator) LoadJSON(locale string, b []byte) error {
+	var catalog Catalog
+	if err := json.Unmarshal(b, &catalog); err != nil {
+		return fmt.Errorf("validator: invalid %s catalog: %w", locale, err)
+	}
+
+	t.AddCatalog(locale, catalog)
+	return nil
+}
+
+// LoadFS loads every <locale>.json file in dir of fsys, such as an embed.FS,
+// and merges each one into the catalog of its locale.
+func (t *Translator) LoadFS(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		b, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+
+		locale := strings.TrimSuffix(path.Base(file), ".json")
+		if err := t.LoadJSON(locale, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetPluralRule sets the plural rule of a locale. Locales without one use
+// English rules: PluralOne for exactly 1 and PluralOther otherwise.
+func (t *Translator) SetPluralRule(locale string, rule PluralRule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.plurals[locale] = rule
+}
+
+// Translate returns the message template for an error code in a locale, with the
+// plural form selected from args. A regional locale such as "de-AT" falls back to
+// its language ("de"), and then to DefaultLocale.
+// Returns false if no catalog has a message for the code.
+func (t *Translator) Translate(locale, code string, args Args) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, candidate := range localeChain(locale) {
+		message, ok := t.catalogs[candidate][code]
+		if ok {
+			return message.template(args, t.pluralRule(candidate)), true
+		}
+	}
+
+	return "", false
+}
+
+// pluralRule returns the plural rule of a locale or of its language.
+func (t *Translator) pluralRule(locale string) PluralRule {
+	for _, candidate := range localeChain(locale) {
+		rule, ok := t.plurals[candidate]
+		if ok {
+			return rule
+		}
+	}
+
+	return englishPlural
+}
+
+// englishPlural is the plural rule of English, German and Nepali.
+func englishPlural(n float64) string {
+	if n == 1 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+// localeChain returns the locales to search for a message, most specific first.
+func localeChain(locale string) []string {
+	locale = strings.ReplaceAll(locale, "_", "-")
+
+	chain := []string{}
+	if locale != "" {
+		chain = append(chain, locale)
+	}
+
+	language, _, found := strings.Cut(locale, "-")
+	if found && language != "" {
+		chain = append(chain, language)
+	}
+
+	if language != DefaultLocale {
+		chain = append(chain, DefaultLocale)
+	}
+
+	return chain
+}
+
+// countOf converts a plural count argument to a number.
+func countOf(arg any) (float64, bool) {
+	if str, ok := arg.(string); ok {
+		n, err := strconv.ParseFloat(str, 64)
+		return n, err == nil
+	}
+
+	n, err := ToFloat64(arg)
+	return n, err == nil
+}
+
+// WithLocale sets the locale of the Validator's messages, e.g. "de" or "ne".
+// Messages missing from that locale fall back to DefaultLocale.
+func WithLocale(locale string) Option {
+	return func(v *Validator) {
+		v.locale = locale
+	}
+}
+
+// WithTranslator sets the Translator used to look up messages,
+// instead of DefaultTranslator.
+func WithTranslator(t *Translator) Option {
+	return func(v *Validator) {
+		v.translator = t
+	}
+}
@@ -0,0 +1,229 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/shivajichalise/validator"
+	_ "github.com/shivajichalise/validator/rules"
+)
+
+func TestLocalizedMessages(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		data   map[string]any
+		rules  map[string][]string
+		field  string
+		want   string
+	}{
+		{
+			name:  "english plural one",
+			data:  map[string]any{"initial": ""},
+			rules: map[string][]string{"initial": {"min:1"}},
+			field: "initial",
+			want:  "initial must be at least 1 character",
+		},
+		{
+			name:  "english plural other",
+			data:  map[string]any{"username": "rick"},
+			rules: map[string][]string{"username": {"min:5"}},
+			field: "username",
+			want:  "username must be at least 5 characters",
+		},
+		{
+			name:   "german",
+			locale: "de",
+			data:   map[string]any{},
+			rules:  map[string][]string{"username": {"required"}},
+			field:  "username",
+			want:   "username ist erforderlich",
+		},
+		{
+			name:   "regional locale falls back to language",
+			locale: "de-AT",
+			data:   map[string]any{"age": "old"},
+			rules:  map[string][]string{"age": {"int"}},
+			field:  "age",
+			want:   "age muss eine ganze Zahl sein",
+		},
+		{
+			name:   "nepali",
+			locale: "ne",
+			data:   map[string]any{"age": "old"},
+			rules:  map[string][]string{"age": {"int"}},
+			field:  "age",
+			want:   "age पूर्णाङ्क हुनुपर्छ",
+		},
+		{
+			name:   "unknown locale falls back to english",
+			locale: "fr",
+			data:   map[string]any{"age": "old"},
+			rules:  map[string][]string{"age": {"int"}},
+			field:  "age",
+			want:   "age must be an integer",
+		},
+		{
+			name:   "code missing from catalog uses rule template",
+			locale: "de",
+			data:   map[string]any{"username": "rick"},
+			rules:  map[string][]string{"username": {"min:five"}},
+			field:  "username",
+			want:   "username: min value must be a valid number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(tt.data, tt.rules, validator.WithLocale(tt.locale))
+			v.Validate()
+
+			messages := v.Errors()[tt.field]
+			if len(messages) != 1 || messages[0] != tt.want {
+				t.Errorf("expected message %q, got: %v", tt.want, v.Errors())
+			}
+		})
+	}
+}
+
+func TestTranslatorCatalogs(t *testing.T) {
+	translator := validator.NewTranslator()
+
+	err := translator.LoadJSON("en", []byte(`{"min": {"count": "min", "one": ":attribute needs a character", "other": ":attribute needs :min characters"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fsys := fstest.MapFS{
+		"i18n/pirate.json": {Data: []byte(`{"required": "Arr, :attribute be missin'"}`)},
+	}
+	if err := translator.LoadFS(fsys, "i18n"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v := validator.Make(
+		map[string]any{"initial": ""},
+		map[string][]string{"initial": {"min:1"}, "name": {"required"}},
+		validator.WithTranslator(translator),
+		validator.WithLocale("pirate"),
+	)
+	v.Validate()
+
+	if got := v.Errors()["initial"]; len(got) != 1 || got[0] != "initial needs a character" {
+		t.Errorf("expected english plural override, got: %v", got)
+	}
+
+	if got := v.Errors()["name"]; len(got) != 1 || got[0] != "Arr, name be missin'" {
+		t.Errorf("expected pirate message, got: %v", got)
+	}
+
+	if err := translator.LoadJSON("en", []byte(`{"min": {"one": "missing other form"}}`)); err == nil {
+		t.Error("expected error for plural message without other form")
+	}
+
+	if err := translator.LoadJSON("en", []byte(`not json`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+
+	// The default translator is not affected by catalogs added to another translator.
+	v = validator.Make(map[string]any{"initial": ""}, map[string][]string{"initial": {"min:1"}})
+	v.Validate()
+
+	if got := v.Errors()["initial"]; len(got) != 1 || got[0] != "initial must be at least 1 character" {
+		t.Errorf("expected default message, got: %v", got)
+	}
+}
+
+func TestCustomMessageOverridesCatalog(t *testing.T) {
+	v := validator.Make(
+		map[string]any{},
+		map[string][]string{"username": {"required"}},
+		validator.WithLocale("de"),
+		validator.WithMessages(map[string]string{"required": ":attribute fehlt"}),
+		validator.WithAttributes(map[string]string{"username": "Benutzername"}),
+	)
+	v.Validate()
+
+	if got := v.Errors()["username"]; len(got) != 1 || got[0] != "Benutzername fehlt" {
+		t.Errorf("expected custom message, got: %v", got)
+	}
+}
+
+// plainMinRule replaces the built-in "min" rule and fails with a plain error.
+type plainMinRule struct{}
+
+func (plainMinRule) Name() string {
+	return "min"
+}
+
+func (plainMinRule) Validate(string, any, ...string) error {
+	return errors.New("too short")
+}
+
+func TestPlainRuleErrorsAreNotTranslated(t *testing.T) {
+	for _, locale := range []string{"en", "de"} {
+		v := validator.Make(
+			map[string]any{"u": "x"},
+			map[string][]string{"u": {"min:3"}},
+			validator.WithRegistry(validator.NewRegistry(plainMinRule{})),
+			validator.WithLocale(locale),
+		)
+		v.Validate()
+
+		if got := v.Errors()["u"]; len(got) != 1 || got[0] != "too short" {
+			t.Errorf("%s: expected the rule's own message, got: %v", locale, got)
+		}
+	}
+}
+
+// catalogCodes lists every error code the validator and the built-in rules report for
+// invalid data. Parameter codes (".param"), unknown_rule and invalid_rule are left out:
+// they report configuration errors to developers and are not translated.
+var catalogCodes = []string{
+	validator.CodeRequired, validator.CodePresent, validator.CodeFilled,
+	"between", "between.incomparable", "between.precision", "between.type", "between.whole_number",
+	"boolean",
+	"confirmed",
+	"different",
+	"email", "email.display_name", "email.disposable", "email.dns", "email.dns_unavailable",
+	"email.empty", "email.idn", "email.length", "email.null_mx", "email.rfc", "email.spoof",
+	"email.strict", "email.type",
+	"email_domain", "email_domain.type",
+	"fits", "fits.type",
+	"float64",
+	"gt", "gt.field", "gt.incomparable", "gt.type", "gt.whole_number",
+	"int",
+	"lt", "lt.field", "lt.incomparable", "lt.type", "lt.whole_number",
+	"max", "max.array", "max.bytes", "max.file", "max.numeric", "max.type",
+	"min", "min.array", "min.bytes", "min.file", "min.numeric", "min.type",
+	"numeric",
+	"required_if", "required_unless", "required_with", "required_with_all",
+	"required_without", "required_without_all",
+	"same",
+	"size", "size.array", "size.bytes", "size.file", "size.numeric", "size.type",
+	"string", "string.empty",
+}
+
+func TestCatalogsCoverRuleCodes(t *testing.T) {
+	for _, locale := range []string{"en", "de", "ne"} {
+		b, err := os.ReadFile(filepath.Join("lang", locale+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var catalog map[string]json.RawMessage
+		if err := json.Unmarshal(b, &catalog); err != nil {
+			t.Fatalf("%s: %v", locale, err)
+		}
+
+		for _, code := range catalogCodes {
+			if _, ok := catalog[code]; !ok {
+				t.Errorf("%s catalog has no message for %q", locale, code)
+			}
+		}
+	}
+}
@@ -1,8 +1,13 @@
 package validator
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 // IsWholeNumber returns true if the given float64 represents a whole number.
@@ -11,18 +16,110 @@ func IsWholeNumber(f float64) bool {
 	return f == float64(int64(f))
 }
 
+// Deref returns the underlying value rules should validate.
+// Pointers are dereferenced, with nil pointers returned as nil, and
+// driver.Valuer types such as sql.NullString or sql.NullInt64 are replaced
+// by their driver value, which is nil when they are not valid.
+// Other values are returned unchanged.
+func Deref(value any) any {
+	for value != nil {
+		// A typed nil pointer may implement driver.Valuer, as *sql.NullString does,
+		// but calling Value on it panics.
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil
+		}
+
+		if valuer, ok := value.(driver.Valuer); ok {
+			driverValue, err := valuer.Value()
+			if err != nil {
+				return value
+			}
+			return driverValue
+		}
+
+		if v.Kind() != reflect.Pointer {
+			return value
+		}
+
+		value = v.Elem().Interface()
+	}
+
+	return nil
+}
+
 // ToFloat64 attempts to convert supported numeric types to float64.
-// Accepts int, int8, int16, int32, int64, float32, and float64.
+// Accepts every signed and unsigned integer kind, float32 and float64, json.Number,
+// *big.Int, *big.Float and *big.Rat, as well as pointers to them and driver.Valuer
+// types holding them (see Deref). The result may be rounded; use CompareNumbers or
+// ToRat for exact comparisons.
 // Returns an error if the value is not a supported numeric type.
 func ToFloat64(value any) (float64, error) {
-	v := reflect.ValueOf(value)
+	value = Deref(value)
+
+	switch n := value.(type) {
+	case json.Number:
+		f, err := strconv.ParseFloat(string(n), 64)
+		if err != nil {
+			return 0, fmt.Errorf("json.Number %q is not a valid number", n)
+		}
+		return f, nil
+	case big.Int:
+		f, _ := new(big.Float).SetInt(&n).Float64()
+		return f, nil
+	case big.Float:
+		f, _ := n.Float64()
+		return f, nil
+	case big.Rat:
+		f, _ := n.Float64()
+		return f, nil
+	}
 
+	v := reflect.ValueOf(value)
 	switch v.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
 	case reflect.Float32, reflect.Float64:
 		return v.Float(), nil
 	default:
 		return 0, fmt.Errorf("value of type %T is not a supported numeric type", value)
 	}
 }
+
+// IsNil reports whether value is nil or a nil pointer, interface, map, slice, channel or func.
+func IsNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// IsEmpty reports whether value is considered empty for presence rules such as "required".
+// Nil values, whitespace-only strings, and empty slices, arrays and maps are empty.
+// The value is passed through Deref before checking, so nil pointers and
+// invalid sql.Null* values are empty.
+func IsEmpty(value any) bool {
+	value = Deref(value)
+	if IsNil(value) {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
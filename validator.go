@@ -1,33 +1,79 @@
 package validator
 
-import "fmt"
+import (
+	"context"
+	"sort"
+)
 
 // data represents input values to be validated.
 // Keys are field names, and values are the actual data.
 type data map[string]any
 
 // rules represents validation rules to be applied to each field.
-// Keys are field names, and values are slices of rule expressions (e.g. "string", "min:5").
+// Keys are field names or paths, and values are slices of rule expressions (e.g. "string", "min:5").
+// Paths use dot notation for nested data ("address.city") and '*' to match
+// every element of a slice or map ("items.*.price").
 type rules map[string][]string
 
+// FieldRules declares the rule expressions of one field or pattern.
+// A list of FieldRules is an ordered rule definition: fields are validated,
+// and their errors reported, in the order they are declared (see MakeOrdered).
+type FieldRules struct {
+	// Field is the field name or path pattern, e.g. "email" or "items.*.price".
+	Field string
+
+	// Rules holds the field's rule expressions, e.g. ["required", "min:5"].
+	Rules []string
+}
+
 // Errors represents a map of validation errors.
-// Keys are field names, and values are slices of error messages for that field.
+// Keys are field names or concrete paths (e.g. "items.3.price"),
+// and values are slices of error messages for that field.
 type Errors map[string][]string
 
+// ruleBail stops running a field's remaining rules after its first failure.
+// Like the presence rules, it is handled by the validator rather than the rule registry.
+const ruleBail = "bail"
+
 // Validator is the core struct that holds input data, validation rules, and error state.
 type Validator struct {
-	data   data
-	rules  rules
-	errors Errors
+	data        data
+	fields      []FieldRules
+	errors      Errors
+	fieldErrors ValidationErrors
+
+	stopOnFirstFailure bool
+	messages           map[string]string
+	attributes         map[string]string
+	locale             string
+	translator         *Translator
+	registry           *Registry
+
+	// compiled holds the rules of a Schema; if nil, rules are compiled by Validate.
+	compiled []compiledField
+}
+
+// Make creates a new Validator instance with the provided data, rules and options.
+// Fields are validated in the order of their rules keys; use MakeOrdered to choose the order.
+func Make(data data, rules rules, opts ...Option) *Validator {
+	return MakeOrdered(data, sortedFields(rules), opts...)
 }
 
-// Make creates a new Validator instance with the provided data and rules.
-func Make(data data, rules rules) *Validator {
-	return &Validator{
+// MakeOrdered creates a new Validator from an ordered rule definition. Fields are
+// validated in the order they are declared, which is also the order of the errors
+// reported by FieldErrors and OrderedErrors.
+func MakeOrdered(data data, fields []FieldRules, opts ...Option) *Validator {
+	v := &Validator{
 		data:   data,
-		rules:  rules,
+		fields: fields,
 		errors: make(Errors),
 	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
 }
 
 // Errors returns the collected validation errors after running Validate().
@@ -35,44 +81,178 @@ func (v *Validator) Errors() Errors {
 	return v.errors
 }
 
-// addError appends an error message to the list of errors for a given field.
-func (v *Validator) addError(field, message string) {
-	v.errors[field] = append(v.errors[field], message)
+// FieldErrors returns the structured validation errors after running Validate(),
+// in the order they were found.
+func (v *Validator) FieldErrors() ValidationErrors {
+	return v.fieldErrors
 }
 
-// parseRule splits a rule expression into the rule name and its parameters.
-// For example, "min:5" becomes ("min", ["5"]).
-func parseRule(expr string) (string, []string) {
-	for i, c := range expr {
-		if c == ':' {
-			return expr[:i], []string{expr[i+1:]}
-		}
+// Err returns the structured validation errors as an error after running Validate(),
+// or nil if validation passed. Use errors.As to extract a *FieldError or ValidationErrors.
+func (v *Validator) Err() error {
+	if len(v.fieldErrors) == 0 {
+		return nil
+	}
+
+	return v.fieldErrors
+}
+
+// OrderedErrors returns the plain string view of the validation errors after running
+// Validate(), with fields in the order they were validated.
+func (v *Validator) OrderedErrors() OrderedErrors {
+	return v.fieldErrors.Ordered()
+}
+
+// addError records a failed validation, keeping the plain message view in sync.
+func (v *Validator) addError(fe *FieldError) {
+	v.fieldErrors = append(v.fieldErrors, fe)
+	v.errors[fe.Field] = append(v.errors[fe.Field], fe.Message)
+}
+
+// sortedFields converts rules to an ordered rule definition, sorted by rules key.
+func sortedFields(rules rules) []FieldRules {
+	fields := make([]FieldRules, 0, len(rules))
+	for field, exprs := range rules {
+		fields = append(fields, FieldRules{Field: field, Rules: exprs})
 	}
-	return expr, nil
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+
+	return fields
 }
 
 // Validate runs all the rules against the data.
 // It populates the internal errors map if any validations fail.
+// With StopOnFirstFailure, it returns as soon as one field has failed.
 // Returns true if validation passes with no errors, false otherwise.
 func (v *Validator) Validate() bool {
-	for field, fieldRules := range v.rules {
-		value := v.data[field]
-
-		for _, ruleExpr := range fieldRules {
-			ruleName, params := parseRule(ruleExpr)
-			rule, exists := GetRule(ruleName)
-			if !exists {
-				v.addError(field, fmt.Sprintf("rule '%s' not found", ruleName))
-				continue
+	return v.ValidateContext(context.Background())
+}
+
+// ValidateContext is like Validate, but passes ctx to rules implementing ContextRule,
+// so I/O-bound rules such as "email:dns" observe its deadline and cancellation, and
+// rules can read request-scoped values from it.
+func (v *Validator) ValidateContext(ctx context.Context) bool {
+	fields := v.compiled
+	if fields == nil {
+		fields = v.compile()
+	}
+
+	for _, f := range fields {
+		for _, field := range expandPath(v.data, f.pattern) {
+			value, exists := lookupPath(v.data, field)
+			v.validateField(ctx, f, field, value, exists)
+
+			if v.stopOnFirstFailure && len(v.errors) > 0 {
+				return false
 			}
+		}
+	}
+
+	return len(v.errors) == 0
+}
 
-			err := rule.Validate(field, value, params...)
-			if err != nil {
-				v.addError(field, err.Error())
+// validateField runs the compiled rules of f against a single field value.
+// exists reports whether the field was found in the data; see checkPresence
+// and implicitOnly for how missing, empty and nil values are handled.
+// If the field's rules include "bail", the chain stops at the first failure.
+// Failures are recorded under the field's concrete path; f.pattern is the rules key
+// the field was expanded from, used to look up custom messages and display names.
+func (v *Validator) validateField(ctx context.Context, f compiledField, field string, value any, exists bool) {
+	if !v.checkPresence(f.pattern, field, value, exists, f.presence) {
+		return
+	}
+
+	implicitOnly := f.presence.implicitOnly(value, exists)
+
+	for _, cr := range f.rules {
+		if cr.err != nil {
+			err := NewError(field, CodeInvalidRule, "rule expression ':rule' is invalid at column :column: :reason",
+				Args{"rule": cr.err.Expr, "column": cr.err.Column, "reason": cr.err.Msg})
+			v.fail(f.pattern, field, cr.expr, nil, value, err)
+			continue
+		}
+
+		if cr.extraParams {
+			v.fail(f.pattern, field, cr.name, cr.params, value, extraParamsError(field, cr.name))
+			continue
+		}
+
+		if cr.rule == nil {
+			err := NewError(field, CodeUnknownRule, "rule ':rule' not found", Args{"rule": cr.name})
+			v.fail(f.pattern, field, cr.name, cr.params, value, err)
+			continue
+		}
+
+		if implicitOnly && !cr.implicit {
+			continue
+		}
+
+		err := v.runRule(ctx, cr.rule, field, value, cr.params)
+		if err != nil {
+			v.fail(f.pattern, field, cr.name, cr.params, value, err)
+
+			if cr.implicit || f.bail {
+				return
 			}
+		}
+	}
+}
+
+// isValidatorRule reports whether a rule name is handled by the validator itself
+// rather than the registry: "bail" and the presence rules.
+func isValidatorRule(name string) bool {
+	switch name {
+	case ruleBail, ruleRequired, ruleNullable, ruleSometimes, rulePresent, ruleFilled:
+		return true
+	default:
+		return false
+	}
+}
 
+// parseBail reports whether a field's rules include "bail", and returns the remaining rules.
+func parseBail(parsed []ParsedRule) (bool, []ParsedRule) {
+	bail := false
+	remaining := make([]ParsedRule, 0, len(parsed))
+
+	for _, pr := range parsed {
+		if pr.Name == ruleBail {
+			bail = true
+			continue
 		}
+		remaining = append(remaining, pr)
 	}
 
-	return len(v.errors) == 0
+	return bail, remaining
+}
+
+// getRule looks a rule up in the Validator's registry, or the default registry if it has none.
+func (v *Validator) getRule(name string) (Rule, bool) {
+	if v.registry == nil {
+		return GetRule(name)
+	}
+
+	return v.registry.Get(name)
+}
+
+// runRule validates a value with a single rule, giving context-aware rules the
+// context and data-aware rules access to the rest of the payload.
+func (v *Validator) runRule(ctx context.Context, rule Rule, field string, value any, params []string) error {
+	if ctxRule, ok := rule.(ContextRule); ok {
+		return ctxRule.ValidateContext(withData(ctx, v.data), field, value, params...)
+	}
+
+	if dataRule, ok := rule.(DataAwareRule); ok {
+		return dataRule.ValidateData(v.data, field, value, params...)
+	}
+
+	return rule.Validate(field, value, params...)
+}
+
+// isImplicit reports whether a rule runs for missing fields.
+func isImplicit(rule Rule) bool {
+	implicitRule, ok := rule.(ImplicitRule)
+	return ok && implicitRule.Implicit()
 }
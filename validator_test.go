@@ -7,6 +7,7 @@
 package validator_test
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/shivajichalise/validator"
@@ -511,3 +512,59 @@ func TestStringRule(t *testing.T) {
 		})
 	}
 }
+
+func TestRulesHandleNilAndPointers(t *testing.T) {
+	age := 42
+	rating := 4.2
+	name := "rickastley"
+	email := "rick@astley.com"
+	active := true
+
+	var nilInt *int
+	var nilString *string
+
+	tests := []struct {
+		name    string
+		value   any
+		rules   []string
+		wantErr bool
+	}{
+		{name: "nil with int", value: nil, rules: []string{"int"}, wantErr: true},
+		{name: "nil with float64", value: nil, rules: []string{"float64"}, wantErr: true},
+		{name: "nil with gt", value: nil, rules: []string{"gt:5"}, wantErr: true},
+		{name: "nil with lt", value: nil, rules: []string{"lt:5"}, wantErr: true},
+		{name: "nil with between", value: nil, rules: []string{"between:1,5"}, wantErr: true},
+		{name: "nil with numeric", value: nil, rules: []string{"numeric"}, wantErr: true},
+		{name: "nil with boolean", value: nil, rules: []string{"boolean"}, wantErr: true},
+		{name: "nil with string", value: nil, rules: []string{"string", "min:1", "max:5"}, wantErr: true},
+		{name: "nil with email", value: nil, rules: []string{"email"}, wantErr: true},
+		{name: "typed nil pointer with int", value: nilInt, rules: []string{"int", "gt:5"}, wantErr: true},
+		{name: "typed nil pointer with string", value: nilString, rules: []string{"string"}, wantErr: true},
+		{name: "pointer to int", value: &age, rules: []string{"int", "numeric", "gt:18", "lt:100", "between:18,100"}, wantErr: false},
+		{name: "pointer to float64", value: &rating, rules: []string{"float64", "gt:4.1"}, wantErr: false},
+		{name: "pointer to string", value: &name, rules: []string{"string", "min:5", "max:20"}, wantErr: false},
+		{name: "pointer to email", value: &email, rules: []string{"email"}, wantErr: false},
+		{name: "pointer to bool", value: &active, rules: []string{"boolean"}, wantErr: false},
+		{name: "valid sql.NullString", value: sql.NullString{String: "rickastley", Valid: true}, rules: []string{"string", "min:5"}, wantErr: false},
+		{name: "invalid sql.NullString", value: sql.NullString{}, rules: []string{"string"}, wantErr: true},
+		{name: "valid sql.NullInt64", value: sql.NullInt64{Int64: 42, Valid: true}, rules: []string{"int", "gt:18"}, wantErr: false},
+		{name: "invalid sql.NullInt64", value: sql.NullInt64{}, rules: []string{"int"}, wantErr: true},
+		{name: "valid sql.NullFloat64", value: &sql.NullFloat64{Float64: 9.5, Valid: true}, rules: []string{"float64", "lt:10.0"}, wantErr: false},
+		{name: "invalid sql.NullInt64 with nullable", value: sql.NullInt64{}, rules: []string{"nullable", "int"}, wantErr: false},
+		{name: "invalid sql.NullString with required", value: sql.NullString{}, rules: []string{"required", "string"}, wantErr: true},
+		{name: "typed nil *sql.NullString", value: (*sql.NullString)(nil), rules: []string{"string"}, wantErr: true},
+		{name: "typed nil *sql.NullString with required", value: (*sql.NullString)(nil), rules: []string{"required", "string"}, wantErr: true},
+		{name: "typed nil *sql.NullInt64 with nullable", value: (*sql.NullInt64)(nil), rules: []string{"nullable", "int"}, wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.Make(map[string]any{"field": tt.value}, map[string][]string{"field": tt.rules})
+			valid := v.Validate()
+
+			if valid == tt.wantErr {
+				t.Errorf("expected valid: %v, got: %v, errors: %v", !tt.wantErr, valid, v.Errors())
+			}
+		})
+	}
+}